@@ -3,6 +3,8 @@ package app
 import (
 	"fmt"
 	"github.com/alice/checkers/app/upgrades/v1tov2"
+	"github.com/alice/checkers/app/upgrades/v2tov3"
+	"github.com/alice/checkers/app/upgrades/v3tov4"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"io"
 	"net/http"
@@ -401,6 +403,8 @@ func NewApp(
 
 	app.CheckersKeeper = *checkersmodulekeeper.NewKeeper(
 		app.BankKeeper,
+		app.DistrKeeper,
+		app.AccountKeeper,
 		appCodec,
 		keys[checkersmoduletypes.StoreKey],
 		keys[checkersmoduletypes.MemStoreKey],
@@ -760,6 +764,22 @@ func (app *App) setupUpgradeHandlers() {
 		},
 	)
 
+	// v2 to v3 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v2tov3.UpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, vm)
+		},
+	)
+
+	// v3 to v4 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v3tov4.UpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, vm)
+		},
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -776,6 +796,8 @@ func (app *App) setupUpgradeHandlers() {
 
 	switch upgradeInfo.Name {
 	case v1tov2.UpgradeName:
+	case v2tov3.UpgradeName:
+	case v3tov4.UpgradeName:
 	}
 
 	if storeUpgrades != nil {
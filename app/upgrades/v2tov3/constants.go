@@ -0,0 +1,5 @@
+package v2tov3
+
+const (
+	UpgradeName = "v2tov3"
+)
@@ -0,0 +1,5 @@
+package v3tov4
+
+const (
+	UpgradeName = "v3tov4"
+)
@@ -23,6 +23,16 @@ func CheckersKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 }
 
 func CheckersKeeperWithMocks(t testing.TB, bank *mock_types.MockBankEscrowKeeper) (*keeper.Keeper, sdk.Context) {
+	return CheckersKeeperWithDistributionMocks(t, bank, nil)
+}
+
+func CheckersKeeperWithDistributionMocks(t testing.TB, bank *mock_types.MockBankEscrowKeeper,
+	distribution *mock_types.MockDistributionKeeper) (*keeper.Keeper, sdk.Context) {
+	return CheckersKeeperWithAccountMocks(t, bank, distribution, nil)
+}
+
+func CheckersKeeperWithAccountMocks(t testing.TB, bank *mock_types.MockBankEscrowKeeper,
+	distribution *mock_types.MockDistributionKeeper, account *mock_types.MockAccountKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -43,6 +53,8 @@ func CheckersKeeperWithMocks(t testing.TB, bank *mock_types.MockBankEscrowKeeper
 	)
 	k := keeper.NewKeeper(
 		bank,
+		distribution,
+		account,
 		cdc,
 		storeKey,
 		memStoreKey,
@@ -29,12 +29,16 @@ func (escrow *MockBankEscrowKeeper) ExpectPay(context context.Context, who strin
 
 func (escrow *MockBankEscrowKeeper) ExpectPayWithDenom(context context.Context, who string,
 	amount uint64, denom string) *gomock.Call {
+	return escrow.ExpectPayCoins(context, who, coinsOf(amount, denom))
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectPayCoins(context context.Context, who string, coins sdk.Coins) *gomock.Call {
 	whoAddr, err := sdk.AccAddressFromBech32(who)
 	if err != nil {
 		panic(err)
 	}
 	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.ModuleName,
-		coinsOf(amount, denom))
+		coins)
 }
 
 func (escrow *MockBankEscrowKeeper) ExpectRefund(context context.Context, who string, amount uint64) *gomock.Call {
@@ -43,10 +47,14 @@ func (escrow *MockBankEscrowKeeper) ExpectRefund(context context.Context, who st
 
 func (escrow *MockBankEscrowKeeper) ExpectRefundWithDenom(context context.Context, who string,
 	amount uint64, denom string) *gomock.Call {
+	return escrow.ExpectRefundCoins(context, who, coinsOf(amount, denom))
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectRefundCoins(context context.Context, who string, coins sdk.Coins) *gomock.Call {
 	whoAddr, err := sdk.AccAddressFromBech32(who)
 	if err != nil {
 		panic(err)
 	}
 	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr,
-		coinsOf(amount, denom))
+		coins)
 }
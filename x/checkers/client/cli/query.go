@@ -33,6 +33,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPlayerInfo())
 	cmd.AddCommand(CmdShowPlayerInfo())
 	cmd.AddCommand(CmdShowLeaderboard())
+	cmd.AddCommand(CmdDrawOffer())
+	cmd.AddCommand(CmdListGameMoves())
+	cmd.AddCommand(CmdGameClocks())
+	cmd.AddCommand(CmdListOpenGames())
+	cmd.AddCommand(CmdShowTotalFees())
+	cmd.AddCommand(CmdListWagerDenoms())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagWager = "wager"
+	flagDenom = "denom"
+)
+
+func CmdListOpenGames() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-open-games",
+		Short: "list the games with a seat open to join",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			wager, err := cmd.Flags().GetUint64(flagWager)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryOpenGamesRequest{
+				Wager:      wager,
+				Denom:      denom,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.OpenGames(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagWager, 0, "Only games with this wager, 0 for any")
+	cmd.Flags().String(flagDenom, "", "Only games with this denom, empty for any")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,7 +29,8 @@ func networkWithStoredGameObjects(t *testing.T, n int) (*network.Network, []type
 
 	for i := 0; i < n; i++ {
 		storedGame := types.StoredGame{
-			Index: strconv.Itoa(i),
+			Index:  strconv.Itoa(i),
+			Status: types.GameStatus_GAME_STATUS_FINISHED,
 		}
 		nullify.Fill(&storedGame)
 		state.StoredGameList = append(state.StoredGameList, storedGame)
@@ -33,6 +33,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
 	cmd.AddCommand(CmdRejectGame())
+	cmd.AddCommand(CmdPlayMoveSequence())
+	cmd.AddCommand(CmdOfferDraw())
+	cmd.AddCommand(CmdAcceptDraw())
+	cmd.AddCommand(CmdDeclineDraw())
+	cmd.AddCommand(CmdResign())
+	cmd.AddCommand(CmdClaimTimeout())
+	cmd.AddCommand(CmdJoinGame())
+	cmd.AddCommand(CmdAcceptGame())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
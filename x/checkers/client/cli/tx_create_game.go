@@ -7,16 +7,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	flagTurnDuration = "turn-duration"
+	flagTimeBank     = "time-bank"
+	flagIncrement    = "increment"
+	flagTurnBlocks   = "turn-blocks"
+	flagBlackWager   = "black-wager"
+	flagRedWager     = "red-wager"
+	flagSponsor      = "sponsor"
+	flagPrize        = "prize"
+)
+
 func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-game [black] [red] [wager] [denom]",
 		Short: "Broadcast message createGame",
-		Args:  cobra.ExactArgs(4),
+		Long: "Broadcast message createGame. Pass \"\" as black or red to leave the seat open to anyone with join-game. " +
+			"Pass 0 and \"\" as wager and denom when each player stakes its own coins with --black-wager and --red-wager, " +
+			"or when a --sponsor puts up the --prize.",
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argBlack := args[0]
 			argRed := args[1]
@@ -25,6 +40,38 @@ func CmdCreateGame() *cobra.Command {
 				return err
 			}
 			argDenom := args[3]
+			argTurnDuration, err := cmd.Flags().GetDuration(flagTurnDuration)
+			if err != nil {
+				return err
+			}
+			argTimeBank, err := cmd.Flags().GetDuration(flagTimeBank)
+			if err != nil {
+				return err
+			}
+			argIncrement, err := cmd.Flags().GetDuration(flagIncrement)
+			if err != nil {
+				return err
+			}
+			argTurnBlocks, err := cmd.Flags().GetUint64(flagTurnBlocks)
+			if err != nil {
+				return err
+			}
+			argBlackWager, err := getCoinsFlag(cmd, flagBlackWager)
+			if err != nil {
+				return err
+			}
+			argRedWager, err := getCoinsFlag(cmd, flagRedWager)
+			if err != nil {
+				return err
+			}
+			argSponsor, err := cmd.Flags().GetString(flagSponsor)
+			if err != nil {
+				return err
+			}
+			argPrize, err := getCoinsFlag(cmd, flagPrize)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -37,6 +84,14 @@ func CmdCreateGame() *cobra.Command {
 				argRed,
 				argWager,
 				argDenom,
+				argTurnDuration,
+				argTimeBank,
+				argIncrement,
+				argTurnBlocks,
+				argBlackWager,
+				argRedWager,
+				argSponsor,
+				argPrize,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -45,7 +100,23 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(flagTurnDuration, 0, "Time each player has to make a move, 0 for the chain default")
+	cmd.Flags().Duration(flagTimeBank, 0, "Total time each player has for the whole game, 0 for no clock")
+	cmd.Flags().Duration(flagIncrement, 0, "Time added to a player's time bank after each of their moves")
+	cmd.Flags().Uint64(flagTurnBlocks, 0, "Blocks each player has to make a move, instead of a turn duration")
+	cmd.Flags().String(flagBlackWager, "", "Coins staked by black, like 10stake,5token, instead of wager and denom")
+	cmd.Flags().String(flagRedWager, "", "Coins staked by red, like 20stake, instead of wager and denom")
+	cmd.Flags().String(flagSponsor, "", "Account putting up the prize, which has to co-sign the transaction")
+	cmd.Flags().String(flagPrize, "", "Coins put up by the sponsor and paid to the winner, like 100stake")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+func getCoinsFlag(cmd *cobra.Command, flag string) (sdk.Coins, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	return sdk.ParseCoinsNormalized(value)
+}
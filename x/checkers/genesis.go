@@ -15,6 +15,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the storedGame
 	for _, elem := range genState.StoredGameList {
 		k.SetStoredGame(ctx, elem)
+		if elem.Status.IsOngoing() {
+			k.AddToDeadlineIndex(ctx, elem)
+			if _, found := elem.GetOpenSeat(); found {
+				k.AddToOpenGameIndex(ctx, elem)
+			}
+		}
 	}
 	// Set all the playerInfo
 	for _, elem := range genState.PlayerInfoList {
@@ -23,6 +29,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set if defined
 	k.SetLeaderboard(ctx, genState.Leaderboard)
 
+	// Set all the gameMove
+	for _, elem := range genState.GameMoveList {
+		k.SetGameMove(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -44,6 +54,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if found {
 		genesis.Leaderboard = leaderboard
 	}
+	genesis.GameMoveList = k.GetAllGameMove(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
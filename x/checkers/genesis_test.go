@@ -2,6 +2,7 @@ package checkers_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/testutil/nullify"
@@ -19,10 +20,15 @@ func TestGenesis(t *testing.T) {
 		},
 		StoredGameList: []types.StoredGame{
 			{
-				Index: "0",
+				Index:          "0",
+				TurnBlocks:     10,
+				DeadlineHeight: 20,
+				Status:         types.GameStatus_GAME_STATUS_OPEN,
 			},
 			{
-				Index: "1",
+				Index:    "1",
+				Deadline: types.DeadlineLayout,
+				Status:   types.GameStatus_GAME_STATUS_OPEN,
 			},
 		},
 		PlayerInfoList: []types.PlayerInfo{
@@ -42,6 +48,16 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		GameMoveList: []types.GameMove{
+			{
+				GameIndex: "0",
+				MoveIndex: 0,
+			},
+			{
+				GameIndex: "0",
+				MoveIndex: 1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -57,5 +73,7 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
 	require.ElementsMatch(t, genesisState.PlayerInfoList, got.PlayerInfoList)
 	require.Equal(t, genesisState.Leaderboard, got.Leaderboard)
+	require.ElementsMatch(t, genesisState.GameMoveList, got.GameMoveList)
+	require.Equal(t, []string{"1", "0"}, k.GetExpiredGameIndices(ctx.WithBlockHeight(21).WithBlockTime(time.Now())))
 	// this line is used by starport scaffolding # genesis/test/assert
 }
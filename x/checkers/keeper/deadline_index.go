@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getDeadlineIndexStoreAndKey returns the index store matching the kind of deadline of the game, and the key
+// of the game in it.
+func (k Keeper) getDeadlineIndexStoreAndKey(ctx sdk.Context, storedGame types.StoredGame) (prefix.Store, []byte) {
+	if storedGame.HasHeightDeadline() {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeadlineHeightIndexKeyPrefix))
+		return store, types.DeadlineHeightIndexKey(storedGame.DeadlineHeight, storedGame.Index)
+	}
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		panic(err)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeadlineTimeIndexKeyPrefix))
+	return store, types.DeadlineTimeIndexKey(deadline, storedGame.Index)
+}
+
+// AddToDeadlineIndex indexes the game under its current deadline
+func (k Keeper) AddToDeadlineIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	store, key := k.getDeadlineIndexStoreAndKey(ctx, storedGame)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, []byte(storedGame.Index))
+	k.setDeadlineIndexCount(ctx, k.GetDeadlineIndexCount(ctx)+1)
+}
+
+// RemoveFromDeadlineIndex removes the game from under its current deadline. Call it before the deadline changes.
+func (k Keeper) RemoveFromDeadlineIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	store, key := k.getDeadlineIndexStoreAndKey(ctx, storedGame)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.setDeadlineIndexCount(ctx, k.GetDeadlineIndexCount(ctx)-1)
+}
+
+// GetDeadlineIndexCount returns the number of games in the deadline indices, kept up to date as they are
+// indexed so that it is known without iterating.
+func (k Keeper) GetDeadlineIndexCount(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.DeadlineIndexCountKey))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+func (k Keeper) setDeadlineIndexCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.DeadlineIndexCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// RecountDeadlineIndex sets the count of indexed games from the indices themselves, for stores that were
+// indexed before the count was kept.
+func (k Keeper) RecountDeadlineIndex(ctx sdk.Context) {
+	count := uint64(0)
+	for _, indexPrefix := range []string{types.DeadlineTimeIndexKeyPrefix, types.DeadlineHeightIndexKeyPrefix} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexPrefix))
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			count++
+		}
+		iterator.Close()
+	}
+	k.setDeadlineIndexCount(ctx, count)
+}
+
+// GetExpiredGameIndices returns the indices of the games whose deadline has passed as of the current block,
+// the wall-clock ones first, each by deadline then game index.
+func (k Keeper) GetExpiredGameIndices(ctx sdk.Context) (gameIndices []string) {
+	gameIndices, _ = k.GetFirstExpiredGameIndices(ctx, math.MaxUint64)
+	return gameIndices
+}
+
+// GetFirstExpiredGameIndices returns at most limit indices, in the order of GetExpiredGameIndices, and whether
+// more expired games remain beyond them.
+func (k Keeper) GetFirstExpiredGameIndices(ctx sdk.Context, limit uint64) (gameIndices []string, hasMore bool) {
+	gameIndices, hasMore = k.getIndexedGamesBefore(ctx, types.DeadlineTimeIndexKeyPrefix,
+		types.DeadlineTimeKey(ctx.BlockTime()), limit, gameIndices)
+	if hasMore {
+		return gameIndices, true
+	}
+	return k.getIndexedGamesBefore(ctx, types.DeadlineHeightIndexKeyPrefix,
+		types.DeadlineHeightKey(ctx.BlockHeight()), limit, gameIndices)
+}
+
+// getIndexedGamesBefore appends to gameIndices, up to limit, the games indexed before end. It reads at most one
+// entry past the limit, to tell whether more remain.
+func (k Keeper) getIndexedGamesBefore(ctx sdk.Context, indexPrefix string, end []byte, limit uint64,
+	gameIndices []string) ([]string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexPrefix))
+	iterator := store.Iterator(nil, end)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(gameIndices)) >= limit {
+			return gameIndices, true
+		}
+		gameIndices = append(gameIndices, string(iterator.Value()))
+	}
+
+	return gameIndices, false
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadlineIndexOrdersByDeadlineThenIndex(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for _, game := range []types.StoredGame{
+		{Index: "1", Deadline: types.FormatDeadline(now.Add(-time.Second))},
+		{Index: "2", Deadline: types.FormatDeadline(now.Add(-time.Hour))},
+		{Index: "3", Deadline: types.FormatDeadline(now.Add(-time.Second))},
+		{Index: "4", Deadline: types.FormatDeadline(now)},
+		{Index: "5", Deadline: types.FormatDeadline(now.Add(time.Hour))},
+		{Index: "6", Deadline: types.FormatDeadline(now.Add(-time.Nanosecond))},
+		{Index: "7", Deadline: types.FormatDeadline(time.Date(1960, time.January, 1, 0, 0, 0, 0, time.UTC))},
+	} {
+		keeper.AddToDeadlineIndex(ctx, game)
+	}
+	require.Equal(t,
+		[]string{"7", "2", "1", "3", "6"},
+		keeper.GetExpiredGameIndices(ctx.WithBlockTime(now)))
+}
+
+func TestDeadlineIndexHeightAfterTime(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "1", TurnBlocks: 10, DeadlineHeight: 99})
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "2", TurnBlocks: 10, DeadlineHeight: 100})
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "3", TurnBlocks: 10, DeadlineHeight: 50})
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "4", Deadline: types.FormatDeadline(now.Add(-time.Second))})
+	require.Equal(t,
+		[]string{"4", "3", "1"},
+		keeper.GetExpiredGameIndices(ctx.WithBlockTime(now).WithBlockHeight(100)))
+}
+
+func TestDeadlineIndexFirstExpiredHasMore(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "1", TurnBlocks: 10, DeadlineHeight: 99})
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "2", TurnBlocks: 10, DeadlineHeight: 50})
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "3", Deadline: types.FormatDeadline(now.Add(-time.Second))})
+	keeper.AddToDeadlineIndex(ctx, types.StoredGame{Index: "4", Deadline: types.FormatDeadline(now.Add(time.Second))})
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(100)
+	gameIndices, hasMore := keeper.GetFirstExpiredGameIndices(ctx, 2)
+	require.Equal(t, []string{"3", "2"}, gameIndices)
+	require.True(t, hasMore)
+	gameIndices, hasMore = keeper.GetFirstExpiredGameIndices(ctx, 1)
+	require.Equal(t, []string{"3"}, gameIndices)
+	require.True(t, hasMore)
+	gameIndices, hasMore = keeper.GetFirstExpiredGameIndices(ctx, 3)
+	require.Equal(t, []string{"3", "2", "1"}, gameIndices)
+	require.False(t, hasMore)
+}
+
+func TestDeadlineIndexRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	game1 := types.StoredGame{Index: "1", Deadline: types.FormatDeadline(now.Add(-time.Second))}
+	game2 := types.StoredGame{Index: "2", Deadline: types.FormatDeadline(now.Add(-time.Second))}
+	keeper.AddToDeadlineIndex(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game2)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
+	require.Equal(t, []string{"2"}, keeper.GetExpiredGameIndices(ctx.WithBlockTime(now)))
+	keeper.RemoveFromDeadlineIndex(ctx, game2)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(now)))
+}
+
+func TestDeadlineIndexCount(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	game1 := types.StoredGame{Index: "1", Deadline: types.FormatDeadline(now)}
+	game2 := types.StoredGame{Index: "2", TurnBlocks: 10, DeadlineHeight: 50}
+	require.EqualValues(t, 0, keeper.GetDeadlineIndexCount(ctx))
+	keeper.AddToDeadlineIndex(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game2)
+	keeper.AddToDeadlineIndex(ctx, game2)
+	require.EqualValues(t, 2, keeper.GetDeadlineIndexCount(ctx))
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
+	require.EqualValues(t, 1, keeper.GetDeadlineIndexCount(ctx))
+	keeper.AddToDeadlineIndex(ctx, game1)
+	keeper.RecountDeadlineIndex(ctx)
+	require.EqualValues(t, 2, keeper.GetDeadlineIndexCount(ctx))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getGameWithDrawOfferTo returns the game only if it is ongoing and has a draw offer that the responder is
+// allowed to answer, i.e. one made by the responder's opponent.
+func (k Keeper) getGameWithDrawOfferTo(ctx sdk.Context, responder string, gameIndex string) (
+	storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+
+	if !storedGame.Status.IsOngoing() {
+		return storedGame, types.ErrGameFinished
+	}
+
+	if _, found := storedGame.GetPlayerColor(responder); !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", responder)
+	}
+
+	var opponent string
+	switch storedGame.DrawOfferer {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		opponent = storedGame.Red
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		opponent = storedGame.Black
+	default:
+		return storedGame, types.ErrNoDrawOffer
+	}
+	if opponent != responder {
+		return storedGame, types.ErrOwnDrawOffer
+	}
+	return storedGame, nil
+}
+
+// MustConcludeDraw ends the game as a draw on its current board. It takes the game out of the deadline index,
+// refunds each player what they paid, and records the draw for both. The caller saves the game.
+func (k *Keeper) MustConcludeDraw(ctx sdk.Context, storedGame *types.StoredGame, outcome types.Outcome) {
+	k.RemoveFromDeadlineIndex(ctx, *storedGame)
+	storedGame.Winner = rules.PieceStrings[rules.DRAW_PLAYER]
+	storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_DRAWN)
+	storedGame.Outcome = outcome
+	storedGame.PositionHistory = nil
+	k.MustRefundWager(ctx, storedGame)
+	k.MustRegisterPlayerDraw(ctx, storedGame)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameDrawnEventType,
+			sdk.NewAttribute(types.GameDrawnEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.GameDrawnEventBoard, storedGame.Board),
+		),
+	)
+}
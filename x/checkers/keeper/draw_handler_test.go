@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcludeDrawRefundsBothAndRecords(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.PlayMove(context, &types.MsgPlayMove{Creator: bob, GameIndex: "1", FromX: 1, FromY: 2, ToX: 2, ToY: 3})
+	msgServer.PlayMove(context, &types.MsgPlayMove{Creator: carol, GameIndex: "1", FromX: 0, FromY: 5, ToX: 1, ToY: 4})
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+
+	game, _ := k.GetStoredGame(ctx, "1")
+	board := game.Board
+	k.MustConcludeDraw(ctx, &game, types.Outcome_OUTCOME_DRAW_AGREED)
+
+	require.Equal(t, "d", game.Winner)
+	require.Equal(t, board, game.Board)
+	require.Equal(t, types.Outcome_OUTCOME_DRAW_AGREED, game.Outcome)
+	require.Empty(t, k.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(365*24*time.Hour))))
+	bobInfo, found := k.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: bob, DrawnCount: 1}, bobInfo)
+	carolInfo, found := k.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: carol, DrawnCount: 1}, carolInfo)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 4)
+	event := events[1]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-drawn",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "board", Value: board},
+		},
+	}, event)
+}
+
+func TestRegisterPlayerDrawWrongWinner(t *testing.T) {
+	_, k, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "The code did not panic!")
+		require.Equal(t, "game is not drawn, winner: b", r)
+	}()
+	k.MustRegisterPlayerDraw(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "b"})
+}
@@ -5,69 +5,79 @@ import (
 	"fmt"
 	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	opponents := map[string]string{
-		rules.PieceStrings[rules.BLACK_PLAYER]: rules.PieceStrings[rules.RED_PLAYER],
-		rules.PieceStrings[rules.RED_PLAYER]:   rules.PieceStrings[rules.BLACK_PLAYER],
+	// Bounded so that a burst of expiries, e.g. after a chain halt, is spread over the next blocks
+	gameIndices, hasBacklog := k.GetFirstExpiredGameIndices(ctx, k.MaxForfeitsPerBlock(ctx))
+
+	for _, gameIndex := range gameIndices {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Indexed game not found " + gameIndex)
+		}
+		k.MustForfeitExpiredGame(ctx, &storedGame)
 	}
 
-	systemInfo, found := k.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
+	// Counting the expired games left would mean iterating over them, so the games still indexed are
+	// reported instead, as an upper bound
+	backlog := uint64(0)
+	if hasBacklog {
+		backlog = k.GetDeadlineIndexCount(ctx)
 	}
+	telemetry.SetGauge(float32(backlog), types.ModuleName, "expired_games_backlog")
+	telemetry.SetGauge(float32(k.GetDeadlineIndexCount(ctx)), types.ModuleName, "indexed_games")
+}
 
-	gameIndex := systemInfo.FifoHeadIndex
-	for {
-		if gameIndex == types.NoFifoIndex {
-			break
-		}
+// MustForfeitExpiredGame settles a game whose deadline has passed, expiring it if it had not become active,
+// otherwise declaring the waiting player the winner.
+func (k Keeper) MustForfeitExpiredGame(ctx sdk.Context, storedGame *types.StoredGame) {
+	opponents := map[string]string{
+		rules.PieceStrings[rules.BLACK_PLAYER]: rules.PieceStrings[rules.RED_PLAYER],
+		rules.PieceStrings[rules.RED_PLAYER]:   rules.PieceStrings[rules.BLACK_PLAYER],
+	}
 
-		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	k.RemoveFromDeadlineIndex(ctx, *storedGame)
+	k.RemoveFromOpenGameIndex(ctx, *storedGame)
+	lastBoard := storedGame.Board
+	if storedGame.Status != types.GameStatus_GAME_STATUS_ACTIVE {
+		storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_EXPIRED)
+		storedGame.Outcome = types.Outcome_OUTCOME_EXPIRED
+		storedGame.DrawOfferer = ""
+		k.MustRefundWager(ctx, storedGame)
+	} else {
+		storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_FORFEITED)
+		var found bool
+		storedGame.Winner, found = opponents[storedGame.Turn]
 		if !found {
-			panic("Fifo head game not found " + systemInfo.FifoHeadIndex)
+			panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
 		}
-		deadline, err := storedGame.GetDeadlineAsTime()
+		k.MustPayWinnings(ctx, storedGame)
+		winnerInfo, _ := k.MustRegisterPlayerForfeit(ctx, storedGame)
+		k.MustAddToLeaderboard(ctx, winnerInfo)
+		flagged, err := storedGame.IsFlagged(ctx.BlockTime())
 		if err != nil {
 			panic(err)
 		}
-
-		if deadline.Before(ctx.BlockTime()) {
-			k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-			lastBoard := storedGame.Board
-			if storedGame.MoveCount <= 1 {
-				k.RemoveStoredGame(ctx, gameIndex)
-				if storedGame.MoveCount == 1 {
-					k.MustRefundWager(ctx, &storedGame)
-				}
-			} else {
-				storedGame.Winner, found = opponents[storedGame.Turn]
-				if !found {
-					panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
-				}
-				k.MustPayWinnings(ctx, &storedGame)
-				winnerInfo, _ := k.MustRegisterPlayerForfeit(ctx, &storedGame)
-				k.MustAddToLeaderboard(ctx, winnerInfo)
-				storedGame.Board = ""
-				k.SetStoredGame(ctx, storedGame)
-			}
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.GameForfeitedEventType,
-					sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
-					sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
-					sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
-				),
-			)
-			gameIndex = systemInfo.FifoHeadIndex
+		if flagged {
+			storedGame.Outcome = types.Outcome_OUTCOME_FLAGGED
+			*storedGame.GetClock(storedGame.Turn) = 0
 		} else {
-			break
+			storedGame.Outcome = types.Outcome_OUTCOME_TIMEOUT
 		}
+		storedGame.PositionHistory = nil
 	}
-
-	k.SetSystemInfo(ctx, systemInfo)
+	k.SetStoredGame(ctx, *storedGame)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.GameForfeitedEventType,
+			sdk.NewAttribute(types.GameForfeitedEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
+		),
+	)
 }
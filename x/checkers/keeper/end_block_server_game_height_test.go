@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/mock_types"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGameWithTurnBlocks(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context).WithBlockHeight(20)
+	context = sdk.WrapSDKContext(ctx)
+	_, err := createAcceptedGame(msgSrvr, context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      45,
+		Denom:      "stake",
+		TurnBlocks: 100,
+	})
+	require.Nil(t, err)
+	game, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 100, game.TurnBlocks)
+	require.EqualValues(t, 120, game.DeadlineHeight)
+	require.Equal(t, "", game.Deadline)
+	require.Equal(t, time.Duration(0), game.TurnDuration)
+}
+
+func TestCreateGameTurnBlocksOutOfBounds(t *testing.T) {
+	msgSrvr, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := createAcceptedGame(msgSrvr, context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      45,
+		Denom:      "stake",
+		TurnBlocks: types.DefaultMinTurnBlocks - 1,
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "9 is out of bounds: turn blocks are invalid")
+}
+
+func TestForfeitByHeight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockHeight(1)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      45,
+		Denom:      "stake",
+		TurnBlocks: 10,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+
+	// Time is irrelevant, only the height counts
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	k.ForfeitExpiredGames(sdk.WrapSDKContext(ctx))
+	game, _ := k.GetStoredGame(ctx, "1")
+	require.Equal(t, types.GameStatus_GAME_STATUS_ACTIVE, game.Status)
+
+	ctx = ctx.WithBlockHeight(12)
+	context = sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	k.ForfeitExpiredGames(context)
+	game, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, types.GameStatus_GAME_STATUS_FORFEITED, game.Status)
+	require.Equal(t, types.Outcome_OUTCOME_TIMEOUT, game.Outcome)
+	require.Equal(t, "r", game.Winner)
+}
@@ -12,8 +12,10 @@ func (suite *IntegrationTestSuite) TestForfeitUnplayedNotPaid() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -42,8 +44,10 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefunded() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
@@ -72,15 +76,17 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	k.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -90,7 +96,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 				Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, forfeitEvent)
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
 		{Key: "sender", Value: checkersModuleAddress},
@@ -101,7 +107,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -120,8 +126,10 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -139,7 +147,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -158,15 +166,17 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	k.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -176,7 +186,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 				Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, forfeitEvent)
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
 		{Key: "sender", Value: checkersModuleAddress},
@@ -206,8 +216,10 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaid() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
@@ -222,6 +234,49 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaid() {
 	suite.RequireBankBalance(0, checkersModuleAddress)
 }
 
+func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidWithRakeToCommunityPool() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.CheckersKeeper
+	params := k.GetParams(suite.ctx)
+	params.Rake = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(suite.ctx, params)
+	poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	game1, found := k.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
+	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
+
+	k.ForfeitExpiredGames(goCtx)
+
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol+36, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	poolAfter := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 9)), poolAfter.Sub(poolBefore))
+	totalFees, err := k.TotalFees(goCtx, &types.QueryTotalFeesRequest{})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), totalFees.TotalFees)
+}
+
 func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
@@ -244,15 +299,17 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	k.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 9)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -262,7 +319,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 				Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, forfeitEvent)
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
 		{Key: "sender", Value: checkersModuleAddress},
@@ -273,7 +330,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Red:     bob,
 		Black:   carol,
@@ -299,8 +356,10 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 	k := suite.app.CheckersKeeper
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
+	k.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	k.SetStoredGame(suite.ctx, game1)
+	k.AddToDeadlineIndex(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -318,7 +377,7 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Red:     bob,
 		Black:   carol,
@@ -344,14 +403,16 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero
 	keeper := suite.app.CheckersKeeper
 	game1, _ := keeper.GetStoredGame(suite.ctx, "1")
 	oldDeadline := types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.RemoveFromDeadlineIndex(suite.ctx, game1)
 	game1.Deadline = oldDeadline
 	keeper.SetStoredGame(suite.ctx, game1)
+	keeper.AddToDeadlineIndex(suite.ctx, game1)
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 9)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -361,7 +422,7 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
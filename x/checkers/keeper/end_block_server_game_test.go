@@ -14,24 +14,26 @@ func TestForfeitUnplayed(t *testing.T) {
 	defer ctrl.Finish()
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
-	_, found = keeper.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -47,7 +49,7 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
@@ -56,24 +58,26 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
-	_, found = keeper.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId: 3,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	event := events[0]
-	require.Len(t, events, 2)
+	event := events[1]
+	require.Len(t, events, 3)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -89,14 +93,14 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
 		Wager:   46,
 		Denom:   "coin",
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -105,29 +109,34 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game2)
 	game2.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game2)
+	keeper.AddToDeadlineIndex(ctx, game2)
 	keeper.ForfeitExpiredGames(context)
 
-	_, found = keeper.GetStoredGame(ctx, "1")
-	require.False(t, found)
-	_, found = keeper.GetStoredGame(ctx, "2")
-	require.False(t, found)
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
+	game2, found = keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game2.Status)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -143,6 +152,71 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	}, event)
 }
 
+func TestForfeit2OldestUnplayedOver2Calls(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.MaxForfeitsPerBlock = 1
+	keeper.SetParams(ctx, params)
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
+		Creator: carol,
+		Black:   alice,
+		Red:     bob,
+		Wager:   46,
+		Denom:   "coin",
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-2)))
+	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game2)
+	game2.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game2)
+	keeper.AddToDeadlineIndex(ctx, game2)
+	keeper.ForfeitExpiredGames(context)
+
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	game2, found = keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_OPEN, game2.Status)
+	require.Equal(t, []string{"2"}, keeper.GetExpiredGameIndices(ctx))
+
+	keeper.ForfeitExpiredGames(context)
+
+	game2, found = keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game2.Status)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx))
+}
+
+func TestForfeitDisabledLeavesExpired(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.MaxForfeitsPerBlock = 0
+	keeper.SetParams(ctx, params)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_OPEN, game1.Status)
+	require.Equal(t, []string{"1"}, keeper.GetExpiredGameIndices(ctx))
+}
+
 func TestForfeitPlayedOnce(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
@@ -159,24 +233,26 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
-	_, found = keeper.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -202,7 +278,7 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 		ToX:       2,
 		ToY:       3,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
@@ -211,24 +287,26 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
-	_, found = keeper.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId: 3,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	event := events[0]
-	require.Len(t, events, 3)
+	event := events[1]
+	require.Len(t, events, 4)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -256,7 +334,7 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		ToX:       2,
 		ToY:       3,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
@@ -271,7 +349,7 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		ToX:       2,
 		ToY:       3,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -280,29 +358,34 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game2)
 	game2.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game2)
+	keeper.AddToDeadlineIndex(ctx, game2)
 	keeper.ForfeitExpiredGames(context)
 
-	_, found = keeper.GetStoredGame(ctx, "1")
-	require.False(t, found)
-	_, found = keeper.GetStoredGame(ctx, "2")
-	require.False(t, found)
+	game1, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
+	game2, found = keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game2.Status)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -343,38 +426,41 @@ func TestForfeitPlayedTwice(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
 	game1, found = keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
-		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    2,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
+		Winner:       "r",
+		Wager:        45,
+		Denom:        "stake",
+		Outcome:      types.Outcome_OUTCOME_TIMEOUT,
+		Status:       types.GameStatus_GAME_STATUS_FORFEITED,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -409,7 +495,7 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
@@ -418,38 +504,41 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
 	game1, found = keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
-		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    2,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
+		Winner:       "r",
+		Wager:        45,
+		Denom:        "stake",
+		Outcome:      types.Outcome_OUTCOME_TIMEOUT,
+		Status:       types.GameStatus_GAME_STATUS_FORFEITED,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId: 3,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	event := events[0]
-	require.Len(t, events, 3)
+	event := events[1]
+	require.Len(t, events, 5)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -487,7 +576,7 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
@@ -510,7 +599,7 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -519,57 +608,65 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game2)
 	game2.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game2)
+	keeper.AddToDeadlineIndex(ctx, game2)
 	keeper.ForfeitExpiredGames(context)
 
 	game1, found = keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
-		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    2,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
+		Winner:       "r",
+		Wager:        45,
+		Denom:        "stake",
+		Outcome:      types.Outcome_OUTCOME_TIMEOUT,
+		Status:       types.GameStatus_GAME_STATUS_FORFEITED,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game1)
 	game2, found = keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "",
-		Turn:        "b",
-		Black:       alice,
-		Red:         bob,
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
-		Winner:      "r",
-		Wager:       46,
-		Denom:       "coin",
+		Index:        "2",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        alice,
+		Red:          bob,
+		MoveCount:    2,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1))),
+		Winner:       "r",
+		Wager:        46,
+		Denom:        "coin",
+		Outcome:      types.Outcome_OUTCOME_TIMEOUT,
+		Status:       types.GameStatus_GAME_STATUS_FORFEITED,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	}, game2)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameForfeitedEventType,
 		Attributes: []sdk.Attribute{
@@ -609,8 +706,10 @@ func TestForfeitGameAddPlayerInfo(t *testing.T) {
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 
 	keeper.ForfeitExpiredGames(context)
 
@@ -682,8 +781,10 @@ func TestForfeitGameUpdatePlayerInfo(t *testing.T) {
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
 	aliceInfo, found := keeper.GetPlayerInfo(ctx, alice)
@@ -737,8 +838,10 @@ func TestForfeitGameLeaderboardAddWinner(t *testing.T) {
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 
 	keeper.ForfeitExpiredGames(context)
 
@@ -813,8 +916,10 @@ func TestForfeitGameLeaderboardUpdateWinner(t *testing.T) {
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
+	keeper.RemoveFromDeadlineIndex(ctx, game1)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	keeper.SetStoredGame(ctx, game1)
+	keeper.AddToDeadlineIndex(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
 	leaderboard, found := keeper.GetLeaderboard(ctx)
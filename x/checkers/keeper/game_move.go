@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetGameMove set a specific gameMove in the store from its index
+func (k Keeper) SetGameMove(ctx sdk.Context, gameMove types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+	b := k.cdc.MustMarshal(&gameMove)
+	store.Set(types.GameMoveKey(
+		gameMove.GameIndex,
+		gameMove.MoveIndex,
+	), b)
+}
+
+// GetGameMove returns a gameMove from its index
+func (k Keeper) GetGameMove(
+	ctx sdk.Context,
+	gameIndex string,
+	moveIndex uint64,
+
+) (val types.GameMove, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+
+	b := store.Get(types.GameMoveKey(
+		gameIndex,
+		moveIndex,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllGameMove returns all gameMove
+func (k Keeper) GetAllGameMove(ctx sdk.Context) (list []types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GameMove
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNGameMove(keeper *keeper.Keeper, ctx sdk.Context, gameIndex string, n int) []types.GameMove {
+	items := make([]types.GameMove, n)
+	for i := range items {
+		items[i].GameIndex = gameIndex
+		items[i].MoveIndex = uint64(i)
+
+		keeper.SetGameMove(ctx, items[i])
+	}
+	return items
+}
+
+func TestGameMoveGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameMove(keeper, ctx, "1", 10)
+	for _, item := range items {
+		rst, found := keeper.GetGameMove(ctx,
+			item.GameIndex,
+			item.MoveIndex,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestGameMoveGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := append(createNGameMove(keeper, ctx, "1", 10), createNGameMove(keeper, ctx, "2", 5)...)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllGameMove(ctx)),
+	)
+}
@@ -25,13 +25,27 @@ func (k Keeper) CanPlayMove(
 		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
 	}
 
-	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+	if !storedGame.Status.IsOngoing() {
 		return &types.QueryCanPlayMoveResponse{
 			Possible: false,
 			Reason:   types.ErrGameFinished.Error(),
 		}, nil
 	}
 
+	if storedGame.Status == types.GameStatus_GAME_STATUS_PENDING {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrGamePending.Error(),
+		}, nil
+	}
+
+	if _, found := storedGame.GetOpenSeat(); found {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrSeatOpen.Error(),
+		}, nil
+	}
+
 	isBlack := rules.PieceStrings[rules.BLACK_PLAYER] == req.Player
 	isRed := rules.PieceStrings[rules.RED_PLAYER] == req.Player
 	var player rules.Player
@@ -26,9 +26,12 @@ var (
 			desc: "First move by black",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -45,9 +48,12 @@ var (
 			desc: "Nil request, wrong",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request:  nil,
 			response: nil,
@@ -57,9 +63,12 @@ var (
 			desc: "Unknown game, wrong",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "2",
@@ -76,9 +85,12 @@ var (
 			desc: "Game finished, wrong",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "b",
+				Status: types.GameStatus_GAME_STATUS_FINISHED,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -98,9 +110,12 @@ var (
 			desc: "Game not parseable, wrong",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -117,9 +132,12 @@ var (
 			desc: "First move by unknown, wrong",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -139,9 +157,12 @@ var (
 			desc: "First move by red, wrong",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -161,9 +182,12 @@ var (
 			desc: "Black can win",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b****|**b*b***|*****b**|********|********|**r*****|*B***b**|********",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -180,9 +204,12 @@ var (
 			desc: "Black must capture, see next for right move",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -202,9 +229,12 @@ var (
 			desc: "Black can capture, same board as previous",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -221,9 +251,12 @@ var (
 			desc: "Black king can capture backwards",
 			game: types.StoredGame{
 				Index:  "1",
+				Black:  alice,
+				Red:    bob,
 				Board:  "*b*b***b|**b*b***|***b***r|********|***r****|********|***r****|r*B*r*r*",
 				Turn:   "b",
 				Winner: "*",
+				Status: types.GameStatus_GAME_STATUS_OPEN,
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -236,6 +269,55 @@ var (
 			response: canPlayOkResponse,
 			err:      "nil",
 		},
+		{
+			desc: "Black must continue jumping with the same piece",
+			game: types.StoredGame{
+				Index:        "1",
+				Black:        alice,
+				Red:          bob,
+				Board:        "********|******b*|*****r**|********|***b****|****r***|********|********",
+				Turn:         "b",
+				Winner:       "*",
+				MustJumpFrom: &types.Position{X: 3, Y: 4},
+				Status:       types.GameStatus_GAME_STATUS_OPEN,
+			},
+			request: &types.QueryCanPlayMoveRequest{
+				GameIndex: "1",
+				Player:    "b",
+				FromX:     6,
+				FromY:     1,
+				ToX:       4,
+				ToY:       3,
+			},
+			response: &types.QueryCanPlayMoveResponse{
+				Possible: false,
+				Reason:   "wrong move: Must continue jumping with piece at: {3 4}",
+			},
+			err: "nil",
+		},
+		{
+			desc: "Black can continue jumping, same board as previous",
+			game: types.StoredGame{
+				Index:        "1",
+				Black:        alice,
+				Red:          bob,
+				Board:        "********|******b*|*****r**|********|***b****|****r***|********|********",
+				Turn:         "b",
+				Winner:       "*",
+				MustJumpFrom: &types.Position{X: 3, Y: 4},
+				Status:       types.GameStatus_GAME_STATUS_OPEN,
+			},
+			request: &types.QueryCanPlayMoveRequest{
+				GameIndex: "1",
+				Player:    "b",
+				FromX:     3,
+				FromY:     4,
+				ToX:       5,
+				ToY:       6,
+			},
+			response: canPlayOkResponse,
+			err:      "nil",
+		},
 	}
 )
 
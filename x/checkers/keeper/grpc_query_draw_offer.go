@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DrawOffer(goCtx context.Context, req *types.QueryDrawOfferRequest) (*types.QueryDrawOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+
+	return &types.QueryDrawOfferResponse{
+		Pending: storedGame.DrawOfferer != "",
+		Offerer: storedGame.DrawOfferer,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrawOfferQuery(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := keeper.DrawOffer(context, &types.QueryDrawOfferRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.EqualValues(t, types.QueryDrawOfferResponse{Pending: false, Offerer: ""}, *response)
+
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	response, err = keeper.DrawOffer(context, &types.QueryDrawOfferRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.EqualValues(t, types.QueryDrawOfferResponse{Pending: true, Offerer: "r"}, *response)
+}
+
+func TestDrawOfferQueryGameNotFound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := keeper.DrawOffer(context, &types.QueryDrawOfferRequest{GameIndex: "2"})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+	_, err = keeper.DrawOffer(context, nil)
+	require.Equal(t, "rpc error: code = InvalidArgument desc = invalid request", err.Error())
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"github.com/alice/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GameClocks(goCtx context.Context, req *types.QueryGameClocksRequest) (*types.QueryGameClocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+
+	// The clocks start once the game is accepted, and stopped when it ended
+	now := ctx.BlockTime()
+	if !storedGame.Status.IsOngoing() || storedGame.Status == types.GameStatus_GAME_STATUS_PENDING {
+		storedGame.Turn = rules.PieceStrings[rules.NO_PLAYER]
+	}
+	blackTimeLeft, err := storedGame.GetTimeLeft(rules.PieceStrings[rules.BLACK_PLAYER], now)
+	if err != nil {
+		return nil, err
+	}
+	redTimeLeft, err := storedGame.GetTimeLeft(rules.PieceStrings[rules.RED_PLAYER], now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGameClocksResponse{
+		HasClock:      storedGame.HasClock(),
+		BlackTimeLeft: blackTimeLeft,
+		RedTimeLeft:   redTimeLeft,
+		Increment:     storedGame.Increment,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OpenGames(c context.Context, req *types.QueryOpenGamesRequest) (*types.QueryOpenGamesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var storedGames []types.StoredGame
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	openGameStore := prefix.NewStore(store, types.KeyPrefix(types.OpenGameIndexKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(openGameStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		storedGame, found := k.GetStoredGame(ctx, string(value))
+		if !found {
+			return false, status.Errorf(codes.Internal, "indexed game not found %s", value)
+		}
+		openSeat, _ := storedGame.GetOpenSeat()
+		if !matchesWager(storedGame.GetWagerOf(openSeat), req.Wager, req.Denom) {
+			return false, nil
+		}
+
+		if accumulate {
+			storedGames = append(storedGames, storedGame)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOpenGamesResponse{StoredGame: storedGames, Pagination: pageRes}, nil
+}
+
+// matchesWager tells whether the wager of the open seat has a coin of the requested amount and denom.
+func matchesWager(seatWager sdk.Coins, wager uint64, denom string) bool {
+	if wager == 0 && denom == "" {
+		return true
+	}
+	for _, coin := range seatWager {
+		if (wager == 0 || coin.Amount.Equal(sdk.NewIntFromUint64(wager))) && (denom == "" || coin.Denom == denom) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOpenGamesFiltered(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	for _, msg := range []types.MsgCreateGame{
+		{Creator: alice, Black: bob, Red: "", Wager: 45, Denom: "stake"},
+		{Creator: alice, Black: bob, Red: carol, Wager: 45, Denom: "stake"},
+		{Creator: alice, Black: "", Red: carol, Wager: 45, Denom: "coin"},
+		{Creator: alice, Black: "", Red: bob, Wager: 46, Denom: "stake"},
+	} {
+		msg := msg
+		_, err := msgServer.CreateGame(context, &msg)
+		require.Nil(t, err)
+	}
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryOpenGamesRequest
+		indices []string
+	}{
+		{desc: "Any", request: &types.QueryOpenGamesRequest{}, indices: []string{"1", "3", "4"}},
+		{desc: "ByWager", request: &types.QueryOpenGamesRequest{Wager: 45}, indices: []string{"1", "3"}},
+		{desc: "ByDenom", request: &types.QueryOpenGamesRequest{Denom: "stake"}, indices: []string{"1", "4"}},
+		{desc: "ByBoth", request: &types.QueryOpenGamesRequest{Wager: 45, Denom: "coin"}, indices: []string{"3"}},
+		{desc: "None", request: &types.QueryOpenGamesRequest{Wager: 47}, indices: []string{}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.OpenGames(context, tc.request)
+			require.Nil(t, err)
+			indices := []string{}
+			for _, storedGame := range response.StoredGame {
+				indices = append(indices, storedGame.Index)
+			}
+			require.Equal(t, tc.indices, indices)
+		})
+	}
+}
+
+func TestOpenGamesFilteredBySeatWager(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      "",
+		Red:        alice,
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("coin", 2), sdk.NewInt64Coin("stake", 10)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	})
+	require.Nil(t, err)
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryOpenGamesRequest
+		found   bool
+	}{
+		{desc: "ByOpenSeatWager", request: &types.QueryOpenGamesRequest{Wager: 10, Denom: "stake"}, found: true},
+		{desc: "ByOtherCoin", request: &types.QueryOpenGamesRequest{Denom: "coin"}, found: true},
+		{desc: "ByTakenSeatWager", request: &types.QueryOpenGamesRequest{Wager: 30}, found: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.OpenGames(context, tc.request)
+			require.Nil(t, err)
+			require.Equal(t, tc.found, len(response.StoredGame) == 1)
+		})
+	}
+}
+
+func TestOpenGamesPaginated(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	for i := 0; i < 5; i++ {
+		_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+			Creator: alice, Black: bob, Red: "", Wager: uint64(45 + i%2), Denom: "stake",
+		})
+		require.Nil(t, err)
+	}
+	response, err := keeper.OpenGames(context, &types.QueryOpenGamesRequest{
+		Wager:      45,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Len(t, response.StoredGame, 2)
+	require.Equal(t, "1", response.StoredGame[0].Index)
+	require.Equal(t, "3", response.StoredGame[1].Index)
+	require.EqualValues(t, 3, response.Pagination.Total)
+	response, err = keeper.OpenGames(context, &types.QueryOpenGamesRequest{
+		Wager:      45,
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey},
+	})
+	require.Nil(t, err)
+	require.Len(t, response.StoredGame, 1)
+	require.Equal(t, "5", response.StoredGame[0].Index)
+}
+
+func TestOpenGamesInvalidRequest(t *testing.T) {
+	_, keeper, context := setupMsgServerCreateGame(t)
+	_, err := keeper.OpenGames(context, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+)
+
+func TestTotalFeesQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	totalFees := sdk.NewCoins(sdk.NewInt64Coin("stake", 9), sdk.NewInt64Coin("token", 3))
+	keeper.SetSystemInfo(ctx, types.SystemInfo{NextId: 2, TotalFees: totalFees})
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryTotalFeesRequest
+		response *types.QueryTotalFeesResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryTotalFeesRequest{},
+			response: &types.QueryTotalFeesResponse{TotalFees: totalFees},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TotalFees(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestTotalFeesQueryNotFound(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	_, err := keeper.TotalFees(sdk.WrapSDKContext(ctx), &types.QueryTotalFeesRequest{})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
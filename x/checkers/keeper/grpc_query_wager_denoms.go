@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) WagerDenoms(c context.Context, req *types.QueryWagerDenomsRequest) (*types.QueryWagerDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryWagerDenomsResponse{WagerDenoms: k.GetWagerDenoms(ctx)}, nil
+}
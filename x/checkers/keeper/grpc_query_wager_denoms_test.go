@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+)
+
+func TestWagerDenomsQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	wagerDenoms := []types.WagerDenom{
+		{Denom: "stake", MinWager: 10},
+		{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", MaxWager: 1_000},
+	}
+	params := keeper.GetParams(ctx)
+	params.WagerDenoms = wagerDenoms
+	keeper.SetParams(ctx, params)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryWagerDenomsRequest
+		response *types.QueryWagerDenomsResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryWagerDenomsRequest{},
+			response: &types.QueryWagerDenomsResponse{WagerDenoms: wagerDenoms},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.WagerDenoms(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
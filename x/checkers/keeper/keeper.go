@@ -13,16 +13,20 @@ import (
 
 type (
 	Keeper struct {
-		bank       types.BankEscrowKeeper
-		cdc        codec.BinaryCodec
-		storeKey   sdk.StoreKey
-		memKey     sdk.StoreKey
-		paramstore paramtypes.Subspace
+		bank         types.BankEscrowKeeper
+		distribution types.DistributionKeeper
+		account      types.AccountKeeper
+		cdc          codec.BinaryCodec
+		storeKey     sdk.StoreKey
+		memKey       sdk.StoreKey
+		paramstore   paramtypes.Subspace
 	}
 )
 
 func NewKeeper(
 	bank types.BankEscrowKeeper,
+	distribution types.DistributionKeeper,
+	account types.AccountKeeper,
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey sdk.StoreKey,
@@ -35,11 +39,13 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		bank:       bank,
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
+		bank:         bank,
+		distribution: distribution,
+		account:      account,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		memKey:       memKey,
+		paramstore:   ps,
 	}
 }
 
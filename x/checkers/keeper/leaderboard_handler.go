@@ -11,7 +11,7 @@ func (k *Keeper) MustAddToLeaderboard(ctx sdk.Context, winnerInfo types.PlayerIn
 	if !found {
 		panic("Leaderboard not found")
 	}
-	err := leaderboard.UpdatePlayerInfoAtNow(types.GetDateAdded(ctx), winnerInfo)
+	err := leaderboard.UpdatePlayerInfoAtNow(types.GetDateAdded(ctx), winnerInfo, k.LeaderboardWinnerLength(ctx))
 	if err != nil {
 		panic(fmt.Sprintf(types.ErrCannotAddToLeaderboard.Error(), err.Error()))
 	}
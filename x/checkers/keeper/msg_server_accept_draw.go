@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptDraw(goCtx context.Context, msg *types.MsgAcceptDraw) (*types.MsgAcceptDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getGameWithDrawOfferTo(ctx, msg.Creator, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	storedGame.DrawOfferer = ""
+	k.Keeper.MustConcludeDraw(ctx, &storedGame, types.Outcome_OUTCOME_DRAW_AGREED)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	return &types.MsgAcceptDrawResponse{}, nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptDrawRefundsBoth(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	response, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptDrawResponse{}, *response)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "d", game1.Winner)
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*", game1.Board)
+	require.Equal(t, types.Outcome_OUTCOME_DRAW_AGREED, game1.Outcome)
+	require.Equal(t, "", game1.DrawOfferer)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(365*24*time.Hour))))
+	bobInfo, _ := keeper.GetPlayerInfo(ctx, bob)
+	require.EqualValues(t, 1, bobInfo.DrawnCount)
+}
+
+func TestAcceptDrawOwnOffer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	response, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "cannot answer own draw offer", err.Error())
+}
+
+func TestAcceptDrawNoOffer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "there is no pending draw offer", err.Error())
+}
+
+func TestAcceptDrawGameFinished(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	response, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game is already finished", err.Error())
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptGame(goCtx context.Context, msg *types.MsgAcceptGame) (*types.MsgAcceptGameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	if storedGame.Status != types.GameStatus_GAME_STATUS_PENDING || !storedGame.RemoveInvitee(msg.Creator) {
+		return nil, sdkerrors.Wrapf(types.ErrNotInvited, "%s", msg.Creator)
+	}
+
+	if len(storedGame.Invitees) == 0 {
+		// The first turn starts once everyone has accepted
+		k.Keeper.RemoveFromDeadlineIndex(ctx, storedGame)
+		storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_OPEN)
+		err := k.Keeper.CollectUpFrontWagers(ctx, &storedGame)
+		if err != nil {
+			return nil, err
+		}
+		storedGame.StartTurn(ctx)
+		k.Keeper.AddToDeadlineIndex(ctx, storedGame)
+	}
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameAcceptedEventType,
+			sdk.NewAttribute(types.GameAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameAcceptedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgAcceptGameResponse{}, nil
+}
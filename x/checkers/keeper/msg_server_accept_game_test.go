@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/testutil/mock_types"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// createAcceptedGame creates the game and has the invited players accept it, so that it can be played.
+func createAcceptedGame(msgServer types.MsgServer, context context.Context, msg *types.MsgCreateGame) (
+	*types.MsgCreateGameResponse, error) {
+	createResponse, err := msgServer.CreateGame(context, msg)
+	if err != nil {
+		return createResponse, err
+	}
+	for _, player := range []string{msg.Black, msg.Red} {
+		msgServer.AcceptGame(context, &types.MsgAcceptGame{
+			Creator:   player,
+			GameIndex: createResponse.GameIndex,
+		})
+	}
+	return createResponse, nil
+}
+
+func setupMsgServerWithOnePendingGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *mock_types.MockBankEscrowKeeper) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	return msgServer, k, context, ctrl, escrow
+}
+
+func TestAcceptGameByOneStaysPending(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	response, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptGameResponse{}, *response)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_PENDING, game.Status)
+	require.Equal(t, []string{carol}, game.Invitees)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)), game.Deadline)
+}
+
+func TestAcceptGameByBothStartsGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err := msgServer.AcceptGame(sdk.WrapSDKContext(later), &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_OPEN, game.Status)
+	require.Empty(t, game.Invitees)
+	require.Equal(t, types.FormatDeadline(later.BlockTime().Add(types.DefaultTurnDuration)), game.Deadline)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultInvitationDuration))))
+}
+
+func TestAcceptGameEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: types.GameAcceptedEventType,
+		Attributes: []sdk.Attribute{
+			{Key: types.GameAcceptedEventCreator, Value: carol},
+			{Key: types.GameAcceptedEventGameIndex, Value: "1"},
+		},
+	}, events[0])
+}
+
+func TestAcceptGameNotInvited(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	defer ctrl.Finish()
+	response, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, alice+": no pending invitation", err.Error())
+}
+
+func TestAcceptGameTwice(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	defer ctrl.Finish()
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	response, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, bob+": no pending invitation", err.Error())
+}
+
+func TestAcceptGameAlreadyStarted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithMocksForJoinGame(t)
+	defer ctrl.Finish()
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	response, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, bob+": no pending invitation", err.Error())
+}
+
+func TestAcceptGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	defer ctrl.Finish()
+	response, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestPlayMovePendingGame(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	defer ctrl.Finish()
+	response, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game is waiting for the invitation to be accepted", err.Error())
+}
+
+func TestRejectPendingGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_REJECTED, game.Status)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultInvitationDuration))))
+}
+
+func TestRejectPendingGameWithTurnBlocksLeavesNothingToExpire(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      45,
+		Denom:      "stake",
+		TurnBlocks: 20,
+	})
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultInvitationDuration + time.Second))
+	require.Empty(t, keeper.GetExpiredGameIndices(later))
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(later))
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_REJECTED, game.Status)
+}
+
+func TestPendingGameExpires(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultInvitationDuration + time.Second))
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(later))
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game.Outcome)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ClaimTimeout(goCtx context.Context, msg *types.MsgClaimTimeout) (*types.MsgClaimTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	if !storedGame.Status.IsOngoing() {
+		return nil, types.ErrGameFinished
+	}
+
+	claimer, found := storedGame.GetPlayerColor(msg.Creator)
+	if storedGame.Status == types.GameStatus_GAME_STATUS_ACTIVE {
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+		}
+		if claimer == storedGame.Turn {
+			return nil, types.ErrCannotClaimOwnTimeout
+		}
+	} else if !found && (storedGame.Sponsor == "" || storedGame.Sponsor != msg.Creator) {
+		// A game that has not started only expires, with refunds, so whose turn it is does not matter
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	expired, err := storedGame.IsExpired(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !expired {
+		return nil, types.ErrGameNotExpired
+	}
+
+	k.Keeper.MustForfeitExpiredGame(ctx, &storedGame)
+
+	return &types.MsgClaimTimeoutResponse{
+		Winner: storedGame.Winner,
+	}, nil
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimTimeoutPaysWaitingPlayer(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	playTwoMovesForResign(msgServer, ctx)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	laterContext := sdk.WrapSDKContext(later)
+	escrow.ExpectRefund(laterContext, carol, 90).Times(1)
+	response, err := msgServer.ClaimTimeout(laterContext, &types.MsgClaimTimeout{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgClaimTimeoutResponse{Winner: "r"}, *response)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game1.Winner)
+	require.Equal(t, types.GameStatus_GAME_STATUS_FORFEITED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_TIMEOUT, game1.Outcome)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(365*24*time.Hour))))
+
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: bob, ForfeitedCount: 1}, bobInfo)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: carol, WonCount: 1}, carolInfo)
+	leaderboard, found := keeper.GetLeaderboard(ctx)
+	require.True(t, found)
+	require.Len(t, leaderboard.Winners, 1)
+	require.Equal(t, carol, leaderboard.Winners[0].PlayerAddress)
+}
+
+func TestClaimTimeoutEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(msgServer, ctx)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	laterContext := sdk.WrapSDKContext(later)
+	escrow.ExpectAny(laterContext)
+	msgServer.ClaimTimeout(laterContext, &types.MsgClaimTimeout{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(later.EventManager().ABCIEvents())
+	require.Len(t, events, 5)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: types.GameForfeitedEventType,
+		Attributes: []sdk.Attribute{
+			{Key: types.GameForfeitedEventGameIndex, Value: "1"},
+			{Key: types.GameForfeitedEventWinner, Value: "r"},
+			{Key: types.GameForfeitedEventBoard,
+				Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[1])
+}
+
+func TestClaimTimeoutBeforeAnyMoveExpires(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	response, err := msgServer.ClaimTimeout(sdk.WrapSDKContext(later), &types.MsgClaimTimeout{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgClaimTimeoutResponse{Winner: "*"}, *response)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Equal(t, types.Outcome_OUTCOME_EXPIRED, game1.Outcome)
+	carolInfo, _ := keeper.GetPlayerInfo(ctx, carol)
+	require.EqualValues(t, 0, carolInfo.WonCount)
+}
+
+func TestClaimTimeoutNotExpired(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := msgServer.ClaimTimeout(context, &types.MsgClaimTimeout{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game has not expired", err.Error())
+}
+
+func TestClaimTimeoutOwnTurn(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(msgServer, ctx)
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	response, err := msgServer.ClaimTimeout(sdk.WrapSDKContext(later), &types.MsgClaimTimeout{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "cannot claim a timeout on own turn", err.Error())
+}
+
+func TestClaimTimeoutOwnTurnBeforeGameStartsExpires(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	response, err := msgServer.ClaimTimeout(sdk.WrapSDKContext(later), &types.MsgClaimTimeout{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgClaimTimeoutResponse{Winner: "*"}, *response)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+}
+
+func TestClaimTimeoutPendingInvitationByBlack(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultInvitationDuration + time.Second))
+	response, err := msgServer.ClaimTimeout(sdk.WrapSDKContext(later), &types.MsgClaimTimeout{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgClaimTimeoutResponse{Winner: "*"}, *response)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	require.Empty(t, keeper.GetExpiredGameIndices(later))
+}
+
+func TestClaimTimeoutNotPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := msgServer.ClaimTimeout(context, &types.MsgClaimTimeout{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestClaimTimeoutGameFinished(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	response, err := msgServer.ClaimTimeout(context, &types.MsgClaimTimeout{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game is already finished", err.Error())
+}
+
+func TestClaimTimeoutGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := msgServer.ClaimTimeout(context, &types.MsgClaimTimeout{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/alice/checkers/x/checkers/rules"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"strconv"
 
 	"github.com/alice/checkers/x/checkers/types"
@@ -18,33 +19,123 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 	}
 	nextIndex := strconv.FormatUint(systemInfo.NextId, 10)
 
+	wagerDenoms := k.Keeper.GetWagerDenoms(ctx)
+	for _, coin := range msg.Prize {
+		if _, found := types.FindWagerDenom(wagerDenoms, coin.Denom); !found {
+			return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+	}
+	if !msg.HasPlayerWagers() && !msg.IsSponsored() {
+		err := types.CheckWager(wagerDenoms, msg.Denom, sdk.NewIntFromUint64(msg.Wager))
+		if err != nil {
+			return nil, err
+		}
+	}
+	blackWager, redWager := msg.GetPlayerWagers()
+	for _, wager := range []sdk.Coins{blackWager, redWager} {
+		for _, coin := range wager {
+			err := types.CheckWager(wagerDenoms, coin.Denom, coin.Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The bounds are governed one key at a time, so they are not known to be consistent with each other
+	turnDuration := msg.TurnDuration
+	if msg.TurnBlocks > 0 {
+		minTurnBlocks, maxTurnBlocks := k.Keeper.MinTurnBlocks(ctx), k.Keeper.MaxTurnBlocks(ctx)
+		if maxTurnBlocks < minTurnBlocks {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidTurnBlocks, "bounds %d to %d are inconsistent",
+				minTurnBlocks, maxTurnBlocks)
+		}
+		if msg.TurnBlocks < minTurnBlocks || maxTurnBlocks < msg.TurnBlocks {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidTurnBlocks, "%d is out of bounds", msg.TurnBlocks)
+		}
+	} else {
+		minTurnDuration, maxTurnDuration := k.Keeper.MinTurnDuration(ctx), k.Keeper.MaxTurnDuration(ctx)
+		if maxTurnDuration < minTurnDuration {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidTurnDuration, "bounds %s to %s are inconsistent",
+				minTurnDuration, maxTurnDuration)
+		}
+		if turnDuration == 0 {
+			turnDuration = types.DefaultTurnDuration
+			if turnDuration < minTurnDuration {
+				turnDuration = minTurnDuration
+			} else if maxTurnDuration < turnDuration {
+				turnDuration = maxTurnDuration
+			}
+		}
+		if turnDuration < minTurnDuration || maxTurnDuration < turnDuration {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidTurnDuration, "%s", turnDuration)
+		}
+	}
+
+	// Players named by someone else have to accept before anything is charged
+	var invitees []string
+	for _, player := range []string{msg.Black, msg.Red} {
+		if player != "" && player != msg.Creator && (len(invitees) == 0 || invitees[0] != player) {
+			invitees = append(invitees, player)
+		}
+	}
+	status := types.GameStatus_GAME_STATUS_OPEN
+	if len(invitees) > 0 {
+		status = types.GameStatus_GAME_STATUS_PENDING
+	}
+
 	newGame := rules.New()
 	storedGame := types.StoredGame{
-		Index:       nextIndex,
-		Board:       newGame.String(),
-		Turn:        rules.PieceStrings[newGame.Turn],
-		Black:       msg.Black,
-		Red:         msg.Red,
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
-		Winner:      rules.PieceStrings[rules.NO_PLAYER],
-		Wager:       msg.Wager,
-		Denom:       msg.Denom,
+		Index:         nextIndex,
+		Board:         newGame.String(),
+		Turn:          rules.PieceStrings[newGame.Turn],
+		Black:         msg.Black,
+		Red:           msg.Red,
+		MoveCount:     0,
+		Winner:        rules.PieceStrings[rules.NO_PLAYER],
+		Wager:         msg.Wager,
+		Denom:         msg.Denom,
+		Status:        status,
+		TurnDuration:  turnDuration,
+		TimeBank:      msg.TimeBank,
+		Increment:     msg.Increment,
+		BlackClock:    msg.TimeBank,
+		RedClock:      msg.TimeBank,
+		TurnBlocks:    msg.TurnBlocks,
+		Invitees:      invitees,
+		UpFrontEscrow: k.Keeper.UpFrontEscrow(ctx),
+		BlackWager:    blackWager,
+		RedWager:      redWager,
+		Sponsor:       msg.Sponsor,
+		Prize:         msg.Prize,
+	}
+	if status == types.GameStatus_GAME_STATUS_PENDING {
+		storedGame.StartInvitation(ctx, k.Keeper.InvitationDuration(ctx))
+	} else {
+		storedGame.StartTurn(ctx)
 	}
 
 	err := storedGame.Validate()
 	if err != nil {
 		return nil, err
 	}
+	err = k.Keeper.CollectPrize(ctx, &storedGame)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.CollectUpFrontWagers(ctx, &storedGame)
+	if err != nil {
+		return nil, err
+	}
 
-	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	k.Keeper.AddToDeadlineIndex(ctx, storedGame)
+	if _, found := storedGame.GetOpenSeat(); found {
+		k.Keeper.AddToOpenGameIndex(ctx, storedGame)
+	}
 	k.Keeper.SetStoredGame(ctx, storedGame)
 	systemInfo.NextId++
 	k.Keeper.SetSystemInfo(ctx, systemInfo)
 
-	ctx.GasMeter().ConsumeGas(types.CreateGameGas, "Create game")
+	ctx.GasMeter().ConsumeGas(k.Keeper.CreateGameGas(ctx), "Create game")
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.GameCreatedEventType,
@@ -54,6 +145,10 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 			sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
 			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
 			sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
+			sdk.NewAttribute(types.GameCreatedEventBlackWager, blackWager.String()),
+			sdk.NewAttribute(types.GameCreatedEventRedWager, redWager.String()),
+			sdk.NewAttribute(types.GameCreatedEventSponsor, msg.Sponsor),
+			sdk.NewAttribute(types.GameCreatedEventPrize, msg.Prize.String()),
 		),
 	)
 
@@ -8,7 +8,7 @@ import (
 func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -19,25 +19,25 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 	systemInfo, found := k.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 	game1, found1 := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found1)
 	suite.Require().EqualValues(types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(suite.ctx.BlockTime().Add(types.DefaultTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_OPEN,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game1)
 }
 
@@ -48,7 +48,7 @@ func (suite *IntegrationTestSuite) TestCreateGameDidNotPlay() {
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -4,6 +4,7 @@ import (
 	goContext "context"
 	"fmt"
 	"testing"
+	"time"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/x/checkers"
@@ -20,9 +21,17 @@ const (
 	badAddress = "notAnAddress"
 )
 
+// allowTestWagerDenoms lets the tests wager in the other denoms they use
+func allowTestWagerDenoms(k keeper.Keeper, ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	params.WagerDenoms = append(params.WagerDenoms, types.WagerDenom{Denom: "coin"}, types.WagerDenom{Denom: "gold"})
+	k.SetParams(ctx, params)
+}
+
 func setupMsgServerCreateGame(t testing.TB) (types.MsgServer, keeper.Keeper, goContext.Context) {
 	k, ctx := keepertest.CheckersKeeper(t)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	allowTestWagerDenoms(*k, ctx)
 	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx)
 }
 
@@ -54,25 +63,26 @@ func TestCreate1GameHasSaved(t *testing.T) {
 	systemInfo, found := k.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 	game, found := k.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{bob, carol},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game)
 }
 
@@ -89,18 +99,21 @@ func TestCreate1GameGetAll(t *testing.T) {
 	games := k.GetAllStoredGame(ctx)
 	require.Len(t, games, 1)
 	require.Equal(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{bob, carol},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, games[0])
 }
 
@@ -126,6 +139,10 @@ func TestCreate1GameEmitted(t *testing.T) {
 			{Key: types.GameCreatedEventRed, Value: carol},
 			{Key: types.GameCreatedEventWager, Value: "45"},
 			{Key: types.GameCreatedEventDenom, Value: "stake"},
+			{Key: types.GameCreatedEventBlackWager, Value: "45stake"},
+			{Key: types.GameCreatedEventRedWager, Value: "45stake"},
+			{Key: types.GameCreatedEventSponsor, Value: ""},
+			{Key: types.GameCreatedEventPrize, Value: ""},
 		},
 	}, events[0])
 }
@@ -145,6 +162,24 @@ func TestCreate1GameConsumedGas(t *testing.T) {
 	require.GreaterOrEqual(t, after, before+15_000)
 }
 
+func TestCreate1GameConsumedGasFromParams(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := k.GetParams(ctx)
+	params.CreateGameGas = 100_000
+	k.SetParams(ctx, params)
+	before := ctx.GasMeter().GasConsumed()
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	after := ctx.GasMeter().GasConsumed()
+	require.GreaterOrEqual(t, after, before+100_000)
+}
+
 func TestCreateGameRedAddressBad(t *testing.T) {
 	msgSrvr, _, context := setupMsgServerCreateGame(t)
 	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
@@ -161,8 +196,8 @@ func TestCreateGameRedAddressBad(t *testing.T) {
 	)
 }
 
-func TestCreateGameEmptyRedAddress(t *testing.T) {
-	msgSrvr, _, context := setupMsgServerCreateGame(t)
+func TestCreateGameOpenRedSeat(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
@@ -170,11 +205,25 @@ func TestCreateGameEmptyRedAddress(t *testing.T) {
 		Wager:   45,
 		Denom:   "stake",
 	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateGameResponse{GameIndex: "1"}, *createResponse)
+	openGames, err := keeper.OpenGames(context, &types.QueryOpenGamesRequest{})
+	require.Nil(t, err)
+	require.Len(t, openGames.StoredGame, 1)
+	require.Equal(t, "", openGames.StoredGame[0].Red)
+}
+
+func TestCreateGameBothSeatsOpen(t *testing.T) {
+	msgSrvr, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   "",
+		Red:     "",
+		Wager:   45,
+		Denom:   "stake",
+	})
 	require.Nil(t, createResponse)
-	require.Error(t,
-		err,
-		"red address is invalid: : empty address string is not allowed",
-	)
+	require.EqualError(t, err, "at most one seat can be left open")
 }
 
 func TestCreate3Games(t *testing.T) {
@@ -226,22 +275,25 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 	})
 	systemInfo, found := k.GetSystemInfo(ctx)
 	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{NextId: 2, FifoHeadIndex: "1", FifoTailIndex: "1"}, systemInfo)
+	require.EqualValues(t, types.SystemInfo{NextId: 2}, systemInfo)
 	storedGame, found := k.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		AfterIndex:  types.NoFifoIndex,
-		BeforeIndex: types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{bob, carol},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, storedGame)
 
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
@@ -253,22 +305,25 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 	})
 	systemInfo, found = k.GetSystemInfo(ctx)
 	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{NextId: 3, FifoHeadIndex: "1", FifoTailIndex: "2"}, systemInfo)
+	require.EqualValues(t, types.SystemInfo{NextId: 3}, systemInfo)
 	storedGame, found = k.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       alice,
-		Red:         bob,
-		MoveCount:   0,
-		BeforeIndex: "1",
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:        "2",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        alice,
+		Red:          bob,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        46,
+		Denom:        "coin",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{alice, bob},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	}, storedGame)
 
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
@@ -280,22 +335,25 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 	})
 	systemInfo, found = k.GetSystemInfo(ctx)
 	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{NextId: 4, FifoHeadIndex: "1", FifoTailIndex: "3"}, systemInfo)
+	require.EqualValues(t, types.SystemInfo{NextId: 4}, systemInfo)
 	storedGame, found = k.GetStoredGame(ctx, "3")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "3",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   0,
-		AfterIndex:  types.NoFifoIndex,
-		BeforeIndex: "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       47,
-		Denom:       "gold",
+		Index:        "3",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        carol,
+		Red:          alice,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        47,
+		Denom:        "gold",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{carol, alice},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	}, storedGame)
 }
 
@@ -326,46 +384,55 @@ func TestCreate3GamesGetAll(t *testing.T) {
 	games := k.GetAllStoredGame(ctx)
 	require.Len(t, games, 3)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{bob, carol},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, games[0])
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       alice,
-		Red:         bob,
-		MoveCount:   0,
-		BeforeIndex: "1",
-		AfterIndex:  "3",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:        "2",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        alice,
+		Red:          bob,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        46,
+		Denom:        "coin",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{alice, bob},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	}, games[1])
 	require.EqualValues(t, types.StoredGame{
-		Index:       "3",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   0,
-		BeforeIndex: "2",
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       47,
-		Denom:       "gold",
+		Index:        "3",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        carol,
+		Red:          alice,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        47,
+		Denom:        "gold",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{carol, alice},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	}, games[2])
 }
 
@@ -387,25 +454,26 @@ func TestCreateGameFarFuture(t *testing.T) {
 	systemInfo, found := k.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        1025,
-		FifoHeadIndex: "1024",
-		FifoTailIndex: "1024",
+		NextId: 1025,
 	}, systemInfo)
 	storedGame, found := k.GetStoredGame(ctx, "1024")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1024",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         alice,
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1024",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          alice,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultInvitationDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_PENDING,
+		Invitees:     []string{bob, alice},
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, storedGame)
 }
 
@@ -595,3 +663,221 @@ func TestCreateLeaderboardNotUpdated(t *testing.T) {
 		},
 	}}, leaderboard)
 }
+
+func TestCreateGameWithTurnDuration(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := createAcceptedGame(msgSrvr, context, &types.MsgCreateGame{
+		Creator:      alice,
+		Black:        bob,
+		Red:          carol,
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: 5 * time.Minute,
+	})
+	require.Nil(t, err)
+	game, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, 5*time.Minute, game.TurnDuration)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(5*time.Minute)), game.Deadline)
+}
+
+func TestCreateGameTurnDurationTooShort(t *testing.T) {
+	msgSrvr, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:      alice,
+		Black:        bob,
+		Red:          carol,
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultMinTurnDuration - 1,
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "59.999999999s: turn duration is out of bounds")
+}
+
+func TestCreateGameTurnDurationTooLong(t *testing.T) {
+	msgSrvr, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:      alice,
+		Black:        bob,
+		Red:          carol,
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultMaxTurnDuration + time.Hour,
+	})
+	require.Nil(t, createResponse)
+	require.ErrorIs(t, err, types.ErrInvalidTurnDuration)
+}
+
+func TestCreateGameDefaultTurnDurationClampedToBounds(t *testing.T) {
+	for _, bounds := range []struct {
+		min, max, expected time.Duration
+	}{
+		{time.Minute, time.Hour, time.Hour},
+		{48 * time.Hour, 72 * time.Hour, 48 * time.Hour},
+	} {
+		msgSrvr, k, context := setupMsgServerCreateGame(t)
+		ctx := sdk.UnwrapSDKContext(context)
+		params := k.GetParams(ctx)
+		params.MinTurnDuration = bounds.min
+		params.MaxTurnDuration = bounds.max
+		k.SetParams(ctx, params)
+		_, err := createAcceptedGame(msgSrvr, context, &types.MsgCreateGame{
+			Creator: alice,
+			Black:   bob,
+			Red:     carol,
+			Wager:   45,
+			Denom:   "stake",
+		})
+		require.Nil(t, err)
+		game, _ := k.GetStoredGame(ctx, "1")
+		require.Equal(t, bounds.expected, game.TurnDuration)
+	}
+}
+
+func TestCreateGameInconsistentTurnDurationBounds(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := k.GetParams(ctx)
+	params.MinTurnDuration = 2 * time.Hour
+	params.MaxTurnDuration = time.Hour
+	k.SetParams(ctx, params)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "bounds 2h0m0s to 1h0m0s are inconsistent: turn duration is out of bounds")
+}
+
+func TestCreateGameInconsistentTurnBlocksBounds(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := k.GetParams(ctx)
+	params.MinTurnBlocks = 20
+	params.MaxTurnBlocks = 10
+	k.SetParams(ctx, params)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      45,
+		Denom:      "stake",
+		TurnBlocks: 15,
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "bounds 20 to 10 are inconsistent: turn blocks are invalid")
+}
+
+func TestCreateGameDenomNotAllowed(t *testing.T) {
+	msgSrvr, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "silver",
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "silver: denom is not accepted for wagers")
+}
+
+func TestCreateGameIbcDenomAllowed(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	params := k.GetParams(ctx)
+	params.WagerDenoms = append(params.WagerDenoms, types.WagerDenom{Denom: ibcDenom})
+	k.SetParams(ctx, params)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   ibcDenom,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateGameResponse{GameIndex: "1"}, *createResponse)
+}
+
+func TestCreateGameWagerOutOfBounds(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := k.GetParams(ctx)
+	params.WagerDenoms = []types.WagerDenom{{Denom: "stake", MinWager: 10, MaxWager: 100}}
+	k.SetParams(ctx, params)
+	for _, tc := range []struct {
+		desc  string
+		wager uint64
+		err   string
+	}{
+		{desc: "BelowMin", wager: 9, err: "9stake: wager is out of bounds"},
+		{desc: "Min", wager: 10},
+		{desc: "Max", wager: 100},
+		{desc: "AboveMax", wager: 101, err: "101stake: wager is out of bounds"},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+				Creator: alice,
+				Black:   bob,
+				Red:     carol,
+				Wager:   tc.wager,
+				Denom:   "stake",
+			})
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateGameWithPlayerWagers(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	blackWager := sdk.NewCoins(sdk.NewInt64Coin("coin", 5), sdk.NewInt64Coin("stake", 10))
+	redWager := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		BlackWager: blackWager,
+		RedWager:   redWager,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateGameResponse{GameIndex: "1"}, *createResponse)
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, uint64(0), storedGame.Wager)
+	require.Equal(t, "", storedGame.Denom)
+	require.Equal(t, blackWager, storedGame.BlackWager)
+	require.Equal(t, redWager, storedGame.RedWager)
+}
+
+func TestCreateGamePlayerWagerNotAllowed(t *testing.T) {
+	msgSrvr, k, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := k.GetParams(ctx)
+	params.WagerDenoms = []types.WagerDenom{{Denom: "stake", MaxWager: 20}}
+	k.SetParams(ctx, params)
+	_, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3)),
+	})
+	require.EqualError(t, err, "token: denom is not accepted for wagers")
+	_, err = msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	})
+	require.EqualError(t, err, "30stake: wager is out of bounds")
+}
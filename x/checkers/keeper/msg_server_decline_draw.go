@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DeclineDraw(goCtx context.Context, msg *types.MsgDeclineDraw) (*types.MsgDeclineDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getGameWithDrawOfferTo(ctx, msg.Creator, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	storedGame.DrawOfferer = ""
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DrawDeclinedEventType,
+			sdk.NewAttribute(types.DrawDeclinedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.DrawDeclinedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgDeclineDrawResponse{}, nil
+}
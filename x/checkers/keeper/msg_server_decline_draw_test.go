@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclineDrawClearsOffer(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	response, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgDeclineDrawResponse{}, *response)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "", game1.DrawOfferer)
+	require.Equal(t, "*", game1.Winner)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 4)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "draw-declined",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+}
+
+func TestDeclineDrawNotPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	response, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
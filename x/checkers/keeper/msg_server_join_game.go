@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) JoinGame(goCtx context.Context, msg *types.MsgJoinGame) (*types.MsgJoinGameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	if !storedGame.Status.IsOngoing() {
+		return nil, types.ErrGameFinished
+	}
+
+	color, found := storedGame.GetOpenSeat()
+	if !found {
+		return nil, types.ErrNoSeatOpen
+	}
+	if storedGame.Black == msg.Creator || storedGame.Red == msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyPlayer, "%s", msg.Creator)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if color == rules.PieceStrings[rules.BLACK_PLAYER] {
+		storedGame.Black = msg.Creator
+	} else {
+		storedGame.Red = msg.Creator
+	}
+	storedGame.JoinedColor = color
+	err = k.Keeper.CollectJoinWager(ctx, &storedGame)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.RemoveFromOpenGameIndex(ctx, storedGame)
+	if storedGame.Status != types.GameStatus_GAME_STATUS_PENDING {
+		// The first turn starts once both players are seated, otherwise once the invitation is accepted
+		k.Keeper.RemoveFromDeadlineIndex(ctx, storedGame)
+		storedGame.StartTurn(ctx)
+		k.Keeper.AddToDeadlineIndex(ctx, storedGame)
+	}
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameJoinedEventType,
+			sdk.NewAttribute(types.GameJoinedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameJoinedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameJoinedEventColor, color),
+		),
+	)
+
+	return &types.MsgJoinGameResponse{
+		Color: color,
+	}, nil
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/mock_types"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithMocksForJoinGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *mock_types.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), ctrl, bankMock
+}
+
+func setupMsgServerWithOneOpenGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *mock_types.MockBankEscrowKeeper) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     "",
+		Wager:   45,
+		Denom:   "stake",
+	})
+	return msgServer, k, context, ctrl, escrow
+}
+
+func TestJoinGameTakesOpenSeat(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneOpenGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgJoinGameResponse{Color: "r"}, *response)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, bob, game1.Black)
+	require.Equal(t, carol, game1.Red)
+	require.Equal(t, "r", game1.JoinedColor)
+	require.Equal(t, types.GameStatus_GAME_STATUS_OPEN, game1.Status)
+	openGames, err := keeper.OpenGames(context, &types.QueryOpenGamesRequest{})
+	require.Nil(t, err)
+	require.Empty(t, openGames.StoredGame)
+}
+
+func TestJoinGameEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneOpenGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-joined",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+			{Key: "color", Value: "r"},
+		},
+	}, events[1])
+}
+
+func TestJoinGameThenPlayCollectsOnce(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneOpenGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+}
+
+func TestJoinGameBlackSeatRefundedOnReject(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	defer ctrl.Finish()
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
+		Creator: carol,
+		Black:   "",
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgJoinGameResponse{Color: "b"}, *response)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	_, err = msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestJoinGameRestartsTurn(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneOpenGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	laterContext := sdk.WrapSDKContext(later)
+	escrow.ExpectAny(laterContext)
+	msgServer.JoinGame(laterContext, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.FormatDeadline(later.BlockTime().Add(types.DefaultTurnDuration)), game1.Deadline)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration+time.Minute))))
+}
+
+func TestPlayMoveSeatOpen(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneOpenGame(t)
+	defer ctrl.Finish()
+	response, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game is waiting for a player to join", err.Error())
+}
+
+func TestOpenGameExpires(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneOpenGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(later))
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game1.Status)
+	openGames, err := keeper.OpenGames(context, &types.QueryOpenGamesRequest{})
+	require.Nil(t, err)
+	require.Empty(t, openGames.StoredGame)
+}
+
+func TestJoinGameNoSeatOpen(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneOpenGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game has no seat open", err.Error())
+}
+
+func TestJoinGameAlreadyPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneOpenGame(t)
+	defer ctrl.Finish()
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, bob+": already a player in this game", err.Error())
+}
+
+func TestJoinGameCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneOpenGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.EXPECT().
+		SendCoinsFromAccountToModule(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("oops"))
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "red cannot pay the wager: oops", err.Error())
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "", game1.Red)
+}
+
+func TestJoinGameFinished(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneOpenGame(t)
+	defer ctrl.Finish()
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game is already finished", err.Error())
+}
+
+func TestJoinGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneOpenGame(t)
+	defer ctrl.Finish()
+	response, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   carol,
+		GameIndex: "2",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
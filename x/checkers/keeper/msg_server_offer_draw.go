@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) OfferDraw(goCtx context.Context, msg *types.MsgOfferDraw) (*types.MsgOfferDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	if !storedGame.Status.IsOngoing() {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.Status == types.GameStatus_GAME_STATUS_PENDING {
+		return nil, types.ErrGamePending
+	}
+
+	if _, found := storedGame.GetOpenSeat(); found {
+		return nil, types.ErrSeatOpen
+	}
+
+	offerer, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	if storedGame.DrawOfferer != "" {
+		return nil, sdkerrors.Wrapf(types.ErrDrawAlreadyOffered, "%s", storedGame.DrawOfferer)
+	}
+
+	storedGame.DrawOfferer = offerer
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DrawOfferedEventType,
+			sdk.NewAttribute(types.DrawOfferedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.DrawOfferedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgOfferDrawResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/alice/checkers/x/checkers/rules"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"strconv"
+	"strings"
 
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,17 +14,50 @@ import (
 func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*types.MsgPlayMoveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	src := rules.Pos{
+		X: int(msg.FromX),
+		Y: int(msg.FromY),
+	}
+	dst := rules.Pos{
+		X: int(msg.ToX),
+		Y: int(msg.ToY),
+	}
+	captured, winner, err := k.Keeper.playPath(ctx, msg.Creator, msg.GameIndex, []rules.Pos{src, dst}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlayMoveResponse{
+		CapturedX: int32(captured[0].X),
+		CapturedY: int32(captured[0].Y),
+		Winner:    winner,
+	}, nil
+}
+
+// playPath plays the hops of the path in order, from the first position to the last, with the same piece. It
+// fails without saving anything if any hop is illegal, or if completeTurn is set and the path stops partway
+// through a multi-jump. It returns the position captured by each hop, NO_POS when there was no capture.
+func (k Keeper) playPath(ctx sdk.Context, creator string, gameIndex string, path []rules.Pos, completeTurn bool) (
+	captured []rules.Pos, winner string, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+		return nil, "", sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+
+	if !storedGame.Status.IsOngoing() {
+		return nil, "", types.ErrGameFinished
 	}
 
-	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
-		return nil, types.ErrGameFinished
+	if storedGame.Status == types.GameStatus_GAME_STATUS_PENDING {
+		return nil, "", types.ErrGamePending
 	}
 
-	isBlack := storedGame.Black == msg.Creator
-	isRed := storedGame.Red == msg.Creator
+	if _, found := storedGame.GetOpenSeat(); found {
+		return nil, "", types.ErrSeatOpen
+	}
+
+	isBlack := storedGame.Black == creator
+	isRed := storedGame.Red == creator
 	var player rules.Player
 	if isBlack && isRed {
 		player = rules.StringPieces[storedGame.Turn].Player
@@ -32,7 +66,7 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 	} else if isRed {
 		player = rules.RED_PLAYER
 	} else {
-		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+		return nil, "", sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
 	}
 
 	game, err := storedGame.ParseGame()
@@ -41,68 +75,139 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 	}
 
 	if !game.TurnIs(player) {
-		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
+		return nil, "", sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
 	}
 
-	err = k.Keeper.CollectWager(ctx, &storedGame)
+	err = storedGame.ChargeClock(ctx.BlockTime())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	src := rules.Pos{
-		X: int(msg.FromX),
-		Y: int(msg.FromY),
+	// The forfeit may be left to a later block, so the player must not escape it by moving late
+	expired, err := storedGame.IsExpired(ctx)
+	if err != nil {
+		return nil, "", err
 	}
-	dst := rules.Pos{
-		X: int(msg.ToX),
-		Y: int(msg.ToY),
+	if expired {
+		return nil, "", types.ErrGameExpired
 	}
-	captured, err := game.Move(src, dst)
+
+	err = k.CollectWager(ctx, &storedGame)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrWrongMove, err.Error())
+		return nil, "", err
 	}
 
-	storedGame.Winner = rules.PieceStrings[game.Winner()]
+	previousHash := game.PositionHash()
+	progress := game.PieceAt(path[0]) && !game.Pieces[path[0]].King
+	captured = make([]rules.Pos, 0, len(path)-1)
+	for hop := 1; hop < len(path); hop++ {
+		hopCaptured, err := game.Move(path[hop-1], path[hop])
+		if err != nil {
+			return nil, "", sdkerrors.Wrapf(types.ErrWrongMove, err.Error())
+		}
+		captured = append(captured, hopCaptured)
+		progress = progress || hopCaptured != rules.NO_POS
+	}
+	if completeTurn && game.MustJumpFrom != rules.NO_POS {
+		return nil, "", sdkerrors.Wrapf(types.ErrWrongMove, "Must continue jumping with piece at: %v", game.MustJumpFrom)
+	}
 
-	systemInfo, found := k.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
+	storedGame.Winner = rules.PieceStrings[game.Winner()]
+	storedGame.RecordPosition(previousHash, game.PositionHash(), progress)
+	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] &&
+		storedGame.IsAutomaticDraw(k.NoProgressMoveLimit(ctx)) {
+		storedGame.Winner = rules.PieceStrings[rules.DRAW_PLAYER]
+		if storedGame.IsThreefoldRepetition() {
+			storedGame.Outcome = types.Outcome_OUTCOME_DRAW_REPETITION
+		} else {
+			storedGame.Outcome = types.Outcome_OUTCOME_DRAW_NO_PROGRESS
+		}
 	}
 
+	for hop, hopCaptured := range captured {
+		k.recordGameMove(ctx, &storedGame, player, storedGame.MoveCount+uint64(hop),
+			path[hop], path[hop+1], hopCaptured)
+	}
+	// Counted before the playing status, which depends on it, is updated
+	storedGame.MoveCount += uint64(len(captured))
 	lastBoard := game.String()
+	storedGame.MustTransitionTo(storedGame.GetPlayingStatus())
 	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
-		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+		// Indexed again once the next turn has started
+		k.RemoveFromDeadlineIndex(ctx, storedGame)
+		storedGame.Board = lastBoard
+	} else if storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER] {
 		storedGame.Board = lastBoard
+		k.MustConcludeDraw(ctx, &storedGame, storedGame.Outcome)
 	} else {
-		k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-		storedGame.Board = ""
-		k.Keeper.MustPayWinnings(ctx, &storedGame)
-		winnerInfo, _ := k.Keeper.MustRegisterPlayerWin(ctx, &storedGame)
-		k.Keeper.MustAddToLeaderboard(ctx, winnerInfo)
+		k.RemoveFromDeadlineIndex(ctx, storedGame)
+		storedGame.Board = lastBoard
+		storedGame.PositionHistory = nil
+		storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_FINISHED)
+		if game.HasPieces(rules.Opponents[game.Winner()]) {
+			storedGame.Outcome = types.Outcome_OUTCOME_BLOCKED
+		} else {
+			storedGame.Outcome = types.Outcome_OUTCOME_CAPTURE_OUT
+		}
+		k.MustPayWinnings(ctx, &storedGame)
+		winnerInfo, _ := k.MustRegisterPlayerWin(ctx, &storedGame)
+		k.MustAddToLeaderboard(ctx, winnerInfo)
 	}
 
-	storedGame.MoveCount++
-	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	if storedGame.DrawOfferer != rules.PieceStrings[player] {
+		// The opponent played instead of answering the offer
+		storedGame.DrawOfferer = ""
+	}
+	if game.MustJumpFrom == rules.NO_POS {
+		// Earned once per turn, not once per hop of a multi-jump
+		storedGame.CreditIncrement()
+	}
 	storedGame.Turn = rules.PieceStrings[game.Turn]
-	k.Keeper.SetStoredGame(ctx, storedGame)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	storedGame.StartTurn(ctx)
+	storedGame.SetMustJumpFromPos(game.MustJumpFrom)
+	if storedGame.Status.IsOngoing() {
+		k.AddToDeadlineIndex(ctx, storedGame)
+	}
+	k.SetStoredGame(ctx, storedGame)
 
-	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
+	ctx.GasMeter().ConsumeGas(k.PlayMoveGas(ctx), "Play a move")
 
+	capturedX := make([]string, len(captured))
+	capturedY := make([]string, len(captured))
+	for i, pos := range captured {
+		capturedX[i] = strconv.FormatInt(int64(pos.X), 10)
+		capturedY[i] = strconv.FormatInt(int64(pos.Y), 10)
+	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.MovePlayedEventType,
-			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
-			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
-			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
-			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
+			sdk.NewAttribute(types.MovePlayedEventCreator, creator),
+			sdk.NewAttribute(types.MovePlayedEventGameIndex, gameIndex),
+			sdk.NewAttribute(types.MovePlayedEventCapturedX, strings.Join(capturedX, types.MovePlayedEventListSeparator)),
+			sdk.NewAttribute(types.MovePlayedEventCapturedY, strings.Join(capturedY, types.MovePlayedEventListSeparator)),
+			sdk.NewAttribute(types.MovePlayedEventWinner, storedGame.Winner),
 			sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
 		),
 	)
 
-	return &types.MsgPlayMoveResponse{
-		CapturedX: int32(captured.X),
-		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
-	}, nil
+	return captured, storedGame.Winner, nil
+}
+
+func (k Keeper) recordGameMove(ctx sdk.Context, storedGame *types.StoredGame, player rules.Player,
+	moveIndex uint64, from rules.Pos, to rules.Pos, captured rules.Pos) {
+	fromPosition := types.NewPosition(from)
+	toPosition := types.NewPosition(to)
+	gameMove := types.GameMove{
+		GameIndex:   storedGame.Index,
+		MoveIndex:   moveIndex,
+		Player:      rules.PieceStrings[player],
+		From:        &fromPosition,
+		To:          &toPosition,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   types.FormatBlockTime(ctx.BlockTime()),
+	}
+	if captured != rules.NO_POS {
+		capturedPosition := types.NewPosition(captured)
+		gameMove.Captured = &capturedPosition
+	}
+	k.SetGameMove(ctx, gameMove)
 }
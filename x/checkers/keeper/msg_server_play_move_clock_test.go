@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	goContext "context"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/mock_types"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// setupMsgServerWithOneGameWithClock creates a game with 10 minutes per player and a 1 minute increment. The
+// returned function advances the block time, and accepts any payment in the new block.
+func setupMsgServerWithOneGameWithClock(t testing.TB) (types.MsgServer, keeper.Keeper, goContext.Context,
+	*gomock.Controller, func(duration time.Duration) goContext.Context) {
+	ctrl := gomock.NewController(t)
+	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	createAcceptedGame(server, context, &types.MsgCreateGame{
+		Creator:   alice,
+		Black:     bob,
+		Red:       carol,
+		Wager:     45,
+		Denom:     "stake",
+		TimeBank:  10 * time.Minute,
+		Increment: time.Minute,
+	})
+	advance := func(duration time.Duration) goContext.Context {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(duration))
+		advanced := sdk.WrapSDKContext(ctx)
+		bankMock.ExpectAny(advanced)
+		return advanced
+	}
+	return server, *k, context, ctrl, advance
+}
+
+func TestCreateGameWithClock(t *testing.T) {
+	_, k, context, ctrl, _ := setupMsgServerWithOneGameWithClock(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	game, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, 10*time.Minute, game.BlackClock)
+	require.Equal(t, 10*time.Minute, game.RedClock)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime()), game.TurnStartedAt)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(10*time.Minute)), game.Deadline)
+}
+
+func TestPlayMoveChargesClock(t *testing.T) {
+	msgServer, k, _, ctrl, advance := setupMsgServerWithOneGameWithClock(t)
+	defer ctrl.Finish()
+	context := advance(3 * time.Minute)
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	ctx := sdk.UnwrapSDKContext(context)
+	game, _ := k.GetStoredGame(ctx, "1")
+	require.Equal(t, 8*time.Minute, game.BlackClock)
+	require.Equal(t, 10*time.Minute, game.RedClock)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime()), game.TurnStartedAt)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(10*time.Minute)), game.Deadline)
+
+	response, err := k.GameClocks(advance(4*time.Minute), &types.QueryGameClocksRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.EqualValues(t, types.QueryGameClocksResponse{
+		HasClock:      true,
+		BlackTimeLeft: 8 * time.Minute,
+		RedTimeLeft:   6 * time.Minute,
+		Increment:     time.Minute,
+	}, *response)
+}
+
+func TestPlayMoveDoubleJumpInTwoMessagesCreditsIncrementOnce(t *testing.T) {
+	msgServer, k, context, ctrl, advance := setupMsgServerWithOneGameWithClock(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	context = advance(time.Minute)
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+	game, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, "b", game.Turn)
+	require.Equal(t, 9*time.Minute, game.BlackClock)
+	context = advance(2 * time.Minute)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       5,
+		ToY:       6,
+	})
+	require.Nil(t, err)
+	game, _ = k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.Equal(t, "r", game.Turn)
+	require.Equal(t, 8*time.Minute, game.BlackClock)
+	require.Equal(t, 10*time.Minute, game.RedClock)
+}
+
+func TestPlayMoveTimeBankExhausted(t *testing.T) {
+	msgServer, k, _, ctrl, advance := setupMsgServerWithOneGameWithClock(t)
+	defer ctrl.Finish()
+	context := advance(10*time.Minute + time.Second)
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "b: time bank is exhausted")
+	game, _ := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.EqualValues(t, 0, game.MoveCount)
+}
+
+func TestForfeitFlaggedPlayer(t *testing.T) {
+	msgServer, k, context, ctrl, advance := setupMsgServerWithOneGameWithClock(t)
+	defer ctrl.Finish()
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	context = advance(11*time.Minute + time.Second)
+	k.ForfeitExpiredGames(context)
+	game, found := k.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.True(t, found)
+	require.Equal(t, "r", game.Winner)
+	require.Equal(t, types.Outcome_OUTCOME_FLAGGED, game.Outcome)
+	require.Equal(t, types.GameStatus_GAME_STATUS_FORFEITED, game.Status)
+	require.Equal(t, time.Duration(0), game.BlackClock)
+	require.Equal(t, 11*time.Minute, game.RedClock)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOnlyKingsForDraw(t *testing.T) (keeper.Keeper, sdk.Context, func(),
+	func(creator string, fromX uint64, fromY uint64, toX uint64, toY uint64) string) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	game1, _ := k.GetStoredGame(ctx, "1")
+	game1.Board = "*B******|********|********|********|********|********|********|******R*"
+	game1.MoveCount = 2
+	game1.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, game1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	play := func(creator string, fromX uint64, fromY uint64, toX uint64, toY uint64) string {
+		response, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+			Creator:   creator,
+			GameIndex: "1",
+			FromX:     fromX,
+			FromY:     fromY,
+			ToX:       toX,
+			ToY:       toY,
+		})
+		require.Nil(t, err)
+		return response.Winner
+	}
+	return k, ctx, ctrl.Finish, play
+}
+
+func TestPlayMoveThreefoldRepetitionDraws(t *testing.T) {
+	k, ctx, finish, play := setupMsgServerWithOnlyKingsForDraw(t)
+	defer finish()
+	for round := 0; round < 2; round++ {
+		require.Equal(t, "*", play(bob, 1, 0, 2, 1))
+		require.Equal(t, "*", play(carol, 6, 7, 5, 6))
+		require.Equal(t, "*", play(bob, 2, 1, 1, 0))
+		if round == 0 {
+			require.Equal(t, "*", play(carol, 5, 6, 6, 7))
+		}
+	}
+	require.Equal(t, "d", play(carol, 5, 6, 6, 7))
+
+	game1, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "d", game1.Winner)
+	require.EqualValues(t, 8, game1.NoProgressCount)
+	require.Equal(t, types.Outcome_OUTCOME_DRAW_REPETITION, game1.Outcome)
+	require.Equal(t, "*B******|********|********|********|********|********|********|******R*", game1.Board)
+	bobInfo, _ := k.GetPlayerInfo(ctx, bob)
+	require.EqualValues(t, 1, bobInfo.DrawnCount)
+	carolInfo, _ := k.GetPlayerInfo(ctx, carol)
+	require.EqualValues(t, 1, carolInfo.DrawnCount)
+}
+
+func TestPlayMoveNoProgressLimitDraws(t *testing.T) {
+	k, ctx, finish, play := setupMsgServerWithOnlyKingsForDraw(t)
+	defer finish()
+	params := k.GetParams(ctx)
+	params.NoProgressMoveLimit = 3
+	k.SetParams(ctx, params)
+	require.Equal(t, "*", play(bob, 1, 0, 2, 1))
+	require.Equal(t, "*", play(carol, 6, 7, 5, 6))
+	require.Equal(t, "d", play(bob, 2, 1, 3, 2))
+	game1, _ := k.GetStoredGame(ctx, "1")
+	require.Equal(t, types.Outcome_OUTCOME_DRAW_NO_PROGRESS, game1.Outcome)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Equal(t, "game-drawn", events[1].Type)
+}
+
+func TestPlayMoveManMoveResetsNoProgress(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	game1, _ := k.GetStoredGame(ctx, "1")
+	game1.Board = "*B******|********|********|********|********|********|*r******|******R*"
+	game1.Turn = "r"
+	game1.NoProgressCount = 5
+	game1.PositionHistory = []string{"a", "b"}
+	k.SetStoredGame(ctx, game1)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     6,
+		ToX:       2,
+		ToY:       5,
+	})
+	game1, _ = k.GetStoredGame(ctx, "1")
+	require.EqualValues(t, 0, game1.NoProgressCount)
+	require.Nil(t, game1.PositionHistory)
+}
@@ -8,7 +8,7 @@ import (
 func (suite *IntegrationTestSuite) setupSuiteWithOneGameForPlayMove() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -32,25 +32,26 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 	systemInfo, found := k.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 	game1, found := k.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   1,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "r",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    1,
+		PaidColors:   []string{"b"},
+		Deadline:     types.FormatDeadline(suite.ctx.BlockTime().Add(types.DefaultTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_OPEN,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game1)
 }
 
@@ -78,7 +79,7 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaid() {
 func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -106,7 +107,7 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
 func (suite *IntegrationTestSuite) TestPlayMoveCannotPayFails() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -143,9 +144,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 		ToY:       3,
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 7)
 
-	playEvent := events[3]
+	playEvent := events[4]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.MovePlayedEventType,
 		Attributes: []sdk.Attribute{
@@ -159,7 +160,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "transfer",
 		Attributes: []sdk.Attribute{
@@ -173,7 +174,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
@@ -189,9 +190,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 		ToY:       3,
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 7)
 
-	playEvent := events[3]
+	playEvent := events[4]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.MovePlayedEventType,
 		Attributes: []sdk.Attribute{
@@ -205,7 +206,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "transfer",
 		Attributes: []sdk.Attribute{
@@ -248,7 +249,7 @@ func (suite *IntegrationTestSuite) TestPlayMove2PlayerPaid() {
 func (suite *IntegrationTestSuite) TestPlayMove2CannotPayFails() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -330,7 +331,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveToWinnerBankPaid() {
 func (suite *IntegrationTestSuite) TestPlayMoveToWinnerBankPaidDifferentTokens() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
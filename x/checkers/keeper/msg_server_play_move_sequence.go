@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"github.com/alice/checkers/x/checkers/rules"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) PlayMoveSequence(goCtx context.Context, msg *types.MsgPlayMoveSequence) (
+	*types.MsgPlayMoveSequenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	path := make([]rules.Pos, len(msg.Positions))
+	for i, position := range msg.Positions {
+		path[i] = position.ToPos()
+	}
+	// A sequence is a whole turn, so it cannot leave a multi-jump for a later message
+	captured, winner, err := k.Keeper.playPath(ctx, msg.Creator, msg.GameIndex, path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	capturedPositions := make([]types.Position, 0, len(captured))
+	for _, pos := range captured {
+		if pos != rules.NO_POS {
+			capturedPositions = append(capturedPositions, types.NewPosition(pos))
+		}
+	}
+	return &types.MsgPlayMoveSequenceResponse{
+		Captured: capturedPositions,
+		Winner:   winner,
+	}, nil
+}
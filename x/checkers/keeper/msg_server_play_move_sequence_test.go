@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	doubleJumpBoard = "********|******b*|*b***r**|**r*****|********|****r***|********|********"
+)
+
+func TestPlayMoveSequenceDoubleJump(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	response, err := msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveSequenceResponse{
+		Captured: []types.Position{{X: 2, Y: 3}, {X: 4, Y: 5}},
+		Winner:   rules.PieceStrings[rules.NO_PLAYER],
+	}, *response)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, "********|******b*|*****r**|********|********|********|*****b**|********", storedGame.Board)
+	require.Equal(t, "r", storedGame.Turn)
+	require.EqualValues(t, 4, storedGame.MoveCount)
+	require.Nil(t, storedGame.MustJumpFrom)
+}
+
+func TestPlayMoveSequenceEmitted(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: types.MovePlayedEventType,
+		Attributes: []sdk.Attribute{
+			{Key: types.MovePlayedEventCreator, Value: bob},
+			{Key: types.MovePlayedEventGameIndex, Value: "1"},
+			{Key: types.MovePlayedEventCapturedX, Value: "2,4"},
+			{Key: types.MovePlayedEventCapturedY, Value: "3,5"},
+			{Key: types.MovePlayedEventWinner, Value: rules.PieceStrings[rules.NO_PLAYER]},
+			{Key: types.MovePlayedEventBoard,
+				Value: "********|******b*|*****r**|********|********|********|*****b**|********"},
+		},
+	}, events[1])
+}
+
+func TestPlayMoveSequenceConsumedGas(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	before := ctx.GasMeter().GasConsumed()
+	msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	after := ctx.GasMeter().GasConsumed()
+	require.GreaterOrEqual(t, after, before+types.DefaultPlayMoveGas)
+}
+
+func TestPlayMoveSequenceWrongSecondHopChangesNothing(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	response, err := msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 1, Y: 6}},
+	})
+	require.Nil(t, response)
+	require.EqualError(t, err, "Invalid move: {3 4} to {1 6}: wrong move")
+	after, _ := k.GetStoredGame(ctx, "1")
+	require.EqualValues(t, storedGame, after)
+}
+
+func TestPlayMoveSequenceTruncatedMultiJumpChangesNothing(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	response, err := msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}},
+	})
+	require.Nil(t, response)
+	require.EqualError(t, err, "Must continue jumping with piece at: {3 4}: wrong move")
+	after, _ := k.GetStoredGame(ctx, "1")
+	require.EqualValues(t, storedGame, after)
+}
+
+func TestPlayMoveSequenceCannotCarryOnAfterTurnEnds(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	response, err := msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}, {X: 3, Y: 4}},
+	})
+	require.Nil(t, response)
+	require.EqualError(t, err, "Not {black}'s turn: wrong move")
+}
+
+func TestPlayMoveSequenceRecordsEachHop(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Board = "*******b|********|*b******|**r*****|********|****r***|********|********"
+	game1.MoveCount = 2
+	game1.PaidColors = []string{"b", "r"}
+	keeper.SetStoredGame(ctx, game1)
+	msgServer.PlayMoveSequence(context, &types.MsgPlayMoveSequence{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	moves, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.EqualValues(t, []types.GameMove{
+		{
+			GameIndex:   "1",
+			MoveIndex:   2,
+			Player:      "b",
+			From:        &types.Position{X: 1, Y: 2},
+			To:          &types.Position{X: 3, Y: 4},
+			Captured:    &types.Position{X: 2, Y: 3},
+			BlockHeight: ctx.BlockHeight(),
+			BlockTime:   types.FormatBlockTime(ctx.BlockTime()),
+		},
+		{
+			GameIndex:   "1",
+			MoveIndex:   3,
+			Player:      "b",
+			From:        &types.Position{X: 3, Y: 4},
+			To:          &types.Position{X: 5, Y: 6},
+			Captured:    &types.Position{X: 4, Y: 5},
+			BlockHeight: ctx.BlockHeight(),
+			BlockTime:   types.FormatBlockTime(ctx.BlockTime()),
+		},
+	}, moves.GameMove)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func setupMsgServerWithOneGameForPlayMove(t testing.TB) (types.MsgServer, keeper.Keeper, goContext.Context,
@@ -21,9 +22,10 @@ func setupMsgServerWithOneGameForPlayMove(t testing.TB) (types.MsgServer, keeper
 	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
 	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	allowTestWagerDenoms(*k, ctx)
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
-	server.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(server, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -73,7 +75,7 @@ func TestPlayMoveSameBlackRed(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(msgServer, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     bob,
@@ -111,25 +113,48 @@ func TestPlayMoveSavedGame(t *testing.T) {
 	})
 	systemInfo, found := k.GetSystemInfo(ctx)
 	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{NextId: 2, FifoHeadIndex: "1", FifoTailIndex: "1"}, systemInfo)
+	require.EqualValues(t, types.SystemInfo{NextId: 2}, systemInfo)
 	storedGame, found := k.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   1,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "r",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    1,
+		PaidColors:   []string{"b"},
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		TurnDuration: types.DefaultTurnDuration,
+		Status:       types.GameStatus_GAME_STATUS_OPEN,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, storedGame)
 }
 
+func TestPlayMoveAfterDeadlineRejected(t *testing.T) {
+	msgServer, k, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	late := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	playMoveResponse, err := msgServer.PlayMove(sdk.WrapSDKContext(late), &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "game has expired, the move is too late")
+	game, _ := k.GetStoredGame(ctx, "1")
+	require.EqualValues(t, 0, game.MoveCount)
+	require.Equal(t, []string{"1"}, k.GetExpiredGameIndices(late))
+}
+
 func TestPlayMoveEmitted(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
@@ -145,7 +170,7 @@ func TestPlayMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
+	require.Len(t, events, 3)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.MovePlayedEventType,
 		Attributes: []sdk.Attribute{
@@ -157,7 +182,7 @@ func TestPlayMoveEmitted(t *testing.T) {
 			{Key: types.MovePlayedEventBoard,
 				Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
-	}, events[0])
+	}, events[1])
 }
 
 func TestPlayMoveCalledBank(t *testing.T) {
@@ -266,6 +291,59 @@ func TestPlayMoveWrongPieceAtDestination(t *testing.T) {
 	}))
 }
 
+func TestPlayMoveMustContinueJumpWithSamePiece(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|******b*|*b***r**|**r*****|********|****r***|********|********"
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	k.SetStoredGame(ctx, storedGame)
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       3,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: 2,
+		CapturedY: 3,
+		Winner:    rules.PieceStrings[rules.NO_PLAYER],
+	}, *playMoveResponse)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", storedGame.Turn)
+	require.EqualValues(t, &types.Position{X: 3, Y: 4}, storedGame.MustJumpFrom)
+
+	playMoveResponse, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     6,
+		FromY:     1,
+		ToX:       4,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "Must continue jumping with piece at: {3 4}: wrong move")
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       5,
+		ToY:       6,
+	})
+	require.Nil(t, err)
+	storedGame, _ = k.GetStoredGame(ctx, "1")
+	require.Equal(t, "r", storedGame.Turn)
+	require.Nil(t, storedGame.MustJumpFrom)
+}
+
 func TestPlayMove2(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
@@ -317,22 +395,25 @@ func TestPlayMove2SavedGame(t *testing.T) {
 	})
 	systemInfo, found := k.GetSystemInfo(ctx)
 	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{NextId: 2, FifoHeadIndex: "1", FifoTailIndex: "1"}, systemInfo)
+	require.EqualValues(t, types.SystemInfo{NextId: 2}, systemInfo)
 	storedGame, found := k.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   2,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    2,
+		PaidColors:   []string{"b", "r"},
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		Status:       types.GameStatus_GAME_STATUS_ACTIVE,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, storedGame)
 }
 
@@ -359,7 +440,7 @@ func TestPlayMove2Emitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
+	require.Len(t, events, 3)
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: types.MovePlayedEventCreator, Value: carol},
 		{Key: types.GameCreatedEventGameIndex, Value: "1"},
@@ -368,7 +449,7 @@ func TestPlayMove2Emitted(t *testing.T) {
 		{Key: types.MovePlayedEventWinner, Value: rules.PieceStrings[rules.NO_PLAYER]},
 		{Key: types.MovePlayedEventBoard,
 			Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
-	}, events[0].Attributes[6:])
+	}, events[1].Attributes[6:])
 }
 
 func TestPlayMove2CalledBank(t *testing.T) {
@@ -461,22 +542,25 @@ func TestPlayMove3SavedGame(t *testing.T) {
 	})
 	systemInfo, found := k.GetSystemInfo(ctx)
 	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{NextId: 2, FifoHeadIndex: "1", FifoTailIndex: "1"}, systemInfo)
+	require.EqualValues(t, types.SystemInfo{NextId: 2}, systemInfo)
 	storedGame, found := k.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   3,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "r",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    3,
+		PaidColors:   []string{"b", "r"},
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultTurnDuration)),
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		Status:       types.GameStatus_GAME_STATUS_ACTIVE,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, storedGame)
 }
 
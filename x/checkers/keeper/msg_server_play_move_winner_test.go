@@ -96,31 +96,32 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(len(game1Moves)),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "b",
-		Wager:       45,
-		Denom:       "stake",
+		Index:        "1",
+		Board:        "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
+		Turn:         "r",
+		Black:        bob,
+		Red:          carol,
+		MoveCount:    uint64(len(game1Moves)),
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultTurnDuration)),
+		Winner:       "b",
+		Wager:        45,
+		Denom:        "stake",
+		Outcome:      types.Outcome_OUTCOME_CAPTURE_OUT,
+		Status:       types.GameStatus_GAME_STATUS_FINISHED,
+		TurnDuration: types.DefaultTurnDuration,
+		BlackWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	}, game)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.Equal(t, types.MovePlayedEventType, event.Type)
 	require.EqualValues(
 		t,
@@ -145,6 +146,40 @@ func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
 	playAllMoves(t, msgServer, context, "1", game1Moves)
 }
 
+func TestPlayMoveBlockedOpponentLoses(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 90).Times(1)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|********|*b******|********|*b******|r*******|********|********"
+	storedGame.MoveCount = 2
+	storedGame.PaidColors = []string{"b", "r"}
+	keeper.SetStoredGame(ctx, storedGame)
+
+	response, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "b", response.Winner)
+	storedGame, _ = keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", storedGame.Winner)
+	require.Equal(t, "r", storedGame.Turn)
+	require.Equal(t, types.Outcome_OUTCOME_BLOCKED, storedGame.Outcome)
+	require.Equal(t, "********|********|********|**b*****|*b******|r*******|********|********", storedGame.Board)
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.WonCount)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, 1, carolInfo.LostCount)
+}
+
 func TestCompleteGameAddPlayerInfo(t *testing.T) {
 	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
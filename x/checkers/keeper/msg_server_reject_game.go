@@ -2,9 +2,9 @@ package keeper
 
 import (
 	"context"
-	"github.com/alice/checkers/x/checkers/rules"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,33 +17,37 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
 	}
 
-	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+	if !storedGame.Status.IsOngoing() {
 		return nil, types.ErrGameFinished
 	}
 
+	// Once both players have moved, the game is active and can only be resigned
+	isActive := storedGame.Status == types.GameStatus_GAME_STATUS_ACTIVE
 	if storedGame.Black == msg.Creator {
-		if storedGame.MoveCount > 0 {
+		if isActive || storedGame.Turn != rules.PieceStrings[rules.BLACK_PLAYER] {
 			return nil, types.ErrBlackAlreadyPlayed
 		}
 	} else if storedGame.Red == msg.Creator {
-		if storedGame.MoveCount > 1 {
+		if isActive {
 			return nil, types.ErrRedAlreadyPlayed
 		}
 	} else {
 		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
 	}
 
-	k.Keeper.MustRefundWager(ctx, &storedGame)
-
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
+	// The deadline index entry depends on the status, so it is removed before the transition
+	k.Keeper.RemoveFromDeadlineIndex(ctx, storedGame)
+	k.Keeper.RemoveFromOpenGameIndex(ctx, storedGame)
+	err := storedGame.TransitionTo(types.GameStatus_GAME_STATUS_REJECTED)
+	if err != nil {
+		return nil, err
 	}
-	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-	k.Keeper.RemoveStoredGame(ctx, msg.GameIndex)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	storedGame.Outcome = types.Outcome_OUTCOME_REJECTED
+	storedGame.DrawOfferer = ""
+	k.Keeper.MustRefundWager(ctx, &storedGame)
+	k.Keeper.SetStoredGame(ctx, storedGame)
 
-	refund := uint64(types.RejectGameRefundGas)
+	refund := k.Keeper.RejectGameRefundGas(ctx)
 	if consumed := ctx.GasMeter().GasConsumed(); consumed < refund {
 		refund = consumed
 	}
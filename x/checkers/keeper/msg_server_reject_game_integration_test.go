@@ -8,7 +8,7 @@ import (
 func (suite *IntegrationTestSuite) setupSuiteWithOneGameForRejectGame() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -95,9 +95,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		GameIndex: "1",
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	rejectEvent := events[2]
+	rejectEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.GameRejectedEventType,
 		Attributes: []sdk.Attribute{
@@ -106,7 +106,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal("transfer", transferEvent.Type)
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -118,7 +118,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -150,7 +150,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZero() {
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createAcceptedGame(suite.msgServer, goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -170,9 +170,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 		GameIndex: "1",
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	rejectEvent := events[2]
+	rejectEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: types.GameRejectedEventType,
 		Attributes: []sdk.Attribute{
@@ -181,7 +181,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal("transfer", transferEvent.Type)
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
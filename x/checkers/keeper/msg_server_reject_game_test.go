@@ -22,7 +22,7 @@ func setupMsgServerWithOneGameForRejectGame(t testing.TB) (types.MsgServer, keep
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
-	server.CreateGame(context, &types.MsgCreateGame{
+	createAcceptedGame(server, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
@@ -54,7 +54,7 @@ func TestRejectGameByBlackNoMove(t *testing.T) {
 	require.EqualValues(t, types.MsgRejectGameResponse{}, *rejectGameResponse)
 }
 
-func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
+func TestRejectGameByBlackNoMoveRejectedGame(t *testing.T) {
 	msgServer, k, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
@@ -67,12 +67,12 @@ func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
 	require.EqualValues(
 		t,
 		types.SystemInfo{
-			NextId:        2,
-			FifoHeadIndex: types.NoFifoIndex,
-			FifoTailIndex: types.NoFifoIndex,
+			NextId: 2,
 		}, systemInfo)
-	_, found = k.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_REJECTED, game.Status)
+	require.Equal(t, types.Outcome_OUTCOME_REJECTED, game.Outcome)
 }
 
 func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
@@ -85,14 +85,14 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
+	require.Len(t, events, 3)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameRejectedEventType,
 		Attributes: []sdk.Attribute{
 			{Key: types.GameRejectedEventCreator, Value: bob},
 			{Key: types.GameRejectedEventGameIndex, Value: "1"},
 		},
-	}, events[0])
+	}, events[1])
 }
 
 func TestRejectGameByBlackRefundedGas(t *testing.T) {
@@ -105,7 +105,8 @@ func TestRejectGameByBlackRefundedGas(t *testing.T) {
 		GameIndex: "1",
 	})
 	after := ctx.GasMeter().GasConsumed()
-	require.LessOrEqual(t, after, before-5000)
+	// The game is kept, so the refund cannot cover all of the rejection
+	require.Less(t, after, before+types.DefaultRejectGameRefundGas)
 }
 
 func TestRejectGameByRedNoMove(t *testing.T) {
@@ -119,7 +120,7 @@ func TestRejectGameByRedNoMove(t *testing.T) {
 	require.EqualValues(t, types.MsgRejectGameResponse{}, *rejectGameResponse)
 }
 
-func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
+func TestRejectGameByRedNoMoveRejectedGame(t *testing.T) {
 	msgServer, k, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
@@ -132,12 +133,12 @@ func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
 	require.EqualValues(
 		t,
 		types.SystemInfo{
-			NextId:        2,
-			FifoHeadIndex: types.NoFifoIndex,
-			FifoTailIndex: types.NoFifoIndex,
+			NextId: 2,
 		}, systemInfo)
-	_, found = k.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_REJECTED, game.Status)
+	require.Equal(t, types.Outcome_OUTCOME_REJECTED, game.Outcome)
 }
 
 func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
@@ -150,14 +151,14 @@ func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
+	require.Len(t, events, 3)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameRejectedEventType,
 		Attributes: []sdk.Attribute{
 			{Key: types.GameRejectedEventCreator, Value: carol},
 			{Key: types.GameRejectedEventGameIndex, Value: "1"},
 		},
-	}, events[0])
+	}, events[1])
 }
 
 func TestRejectGameByRedOneMove(t *testing.T) {
@@ -180,7 +181,7 @@ func TestRejectGameByRedOneMove(t *testing.T) {
 	require.EqualValues(t, types.MsgRejectGameResponse{}, *rejectGameResponse)
 }
 
-func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
+func TestRejectGameByRedOneMoveRejectedGame(t *testing.T) {
 	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
@@ -202,12 +203,12 @@ func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
 	require.EqualValues(
 		t,
 		types.SystemInfo{
-			NextId:        2,
-			FifoHeadIndex: types.NoFifoIndex,
-			FifoTailIndex: types.NoFifoIndex,
+			NextId: 2,
 		}, systemInfo)
-	_, found = k.GetStoredGame(ctx, "1")
-	require.False(t, found)
+	game, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_REJECTED, game.Status)
+	require.Equal(t, types.Outcome_OUTCOME_REJECTED, game.Outcome)
 }
 
 func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
@@ -229,14 +230,14 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
+	require.Len(t, events, 4)
 	require.EqualValues(t, sdk.StringEvent{
 		Type: types.GameRejectedEventType,
 		Attributes: []sdk.Attribute{
 			{Key: types.GameRejectedEventCreator, Value: carol},
 			{Key: types.GameRejectedEventGameIndex, Value: "1"},
 		},
-	}, events[0])
+	}, events[1])
 }
 
 func TestRejectGameByRedOneCalledBank(t *testing.T) {
@@ -476,3 +477,39 @@ func TestRejectLeaderboardNotUpdated(t *testing.T) {
 		},
 	}}, leaderboard)
 }
+
+func TestRejectGameKeepsMoves(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	_, found := keeper.GetGameMove(ctx, "1", 0)
+	require.True(t, found)
+}
+
+func TestRejectGameAlreadyRejected(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	defer ctrl.Finish()
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, rejectGameResponse)
+	require.Equal(t, types.ErrGameFinished, err)
+}
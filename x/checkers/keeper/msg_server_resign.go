@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"github.com/alice/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) Resign(goCtx context.Context, msg *types.MsgResign) (*types.MsgResignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+
+	if !storedGame.Status.IsOngoing() {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.Status == types.GameStatus_GAME_STATUS_PENDING {
+		return nil, types.ErrGamePending
+	}
+
+	if _, found := storedGame.GetOpenSeat(); found {
+		return nil, types.ErrSeatOpen
+	}
+
+	resigner, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	wasActive := storedGame.Status == types.GameStatus_GAME_STATUS_ACTIVE
+	storedGame.Winner = rules.PieceStrings[rules.Opponents[rules.StringPieces[resigner].Player]]
+	k.Keeper.RemoveFromDeadlineIndex(ctx, storedGame)
+	storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_FINISHED)
+	storedGame.Outcome = types.Outcome_OUTCOME_RESIGNATION
+	storedGame.PositionHistory = nil
+	storedGame.DrawOfferer = ""
+	// The players of a sponsored game risk nothing of their own, so the prize has to be won over the board
+	if storedGame.HasBothPaid() && (storedGame.Sponsor == "" || wasActive) {
+		k.Keeper.MustPayWinnings(ctx, &storedGame)
+	} else {
+		// A player has not put its wager at risk yet, as when the game expires
+		k.Keeper.MustRefundWager(ctx, &storedGame)
+	}
+	winnerInfo, _ := k.Keeper.MustRegisterPlayerResignation(ctx, &storedGame)
+	k.Keeper.MustAddToLeaderboard(ctx, winnerInfo)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameResignedEventType,
+			sdk.NewAttribute(types.GameResignedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameResignedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameResignedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.GameResignedEventBoard, storedGame.Board),
+		),
+	)
+
+	return &types.MsgResignResponse{
+		Winner: storedGame.Winner,
+	}, nil
+}
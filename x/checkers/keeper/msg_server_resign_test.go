@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func playTwoMovesForResign(msgServer types.MsgServer, context sdk.Context) {
+	goCtx := sdk.WrapSDKContext(context)
+	msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+}
+
+func TestResignPaysOpponent(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	playTwoMovesForResign(msgServer, ctx)
+	escrow.ExpectRefund(context, carol, 90).Times(1)
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgResignResponse{Winner: "r"}, *response)
+
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game1.Winner)
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*", game1.Board)
+	require.Equal(t, types.Outcome_OUTCOME_RESIGNATION, game1.Outcome)
+	require.EqualValues(t, 2, game1.MoveCount)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(365*24*time.Hour))))
+
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: bob, ResignedCount: 1}, bobInfo)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{Index: carol, WonCount: 1}, carolInfo)
+	leaderboard, found := keeper.GetLeaderboard(ctx)
+	require.True(t, found)
+	require.Len(t, leaderboard.Winners, 1)
+	require.Equal(t, carol, leaderboard.Winners[0].PlayerAddress)
+}
+
+func TestResignEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(msgServer, ctx)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 5)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-resigned",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "b"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[1])
+}
+
+func TestResignBeforeAnyMovePaysNothing(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgResignResponse{Winner: "b"}, *response)
+	carolInfo, _ := keeper.GetPlayerInfo(ctx, carol)
+	require.EqualValues(t, 1, carolInfo.ResignedCount)
+	require.EqualValues(t, 0, carolInfo.ForfeitedCount)
+	require.EqualValues(t, 0, carolInfo.LostCount)
+}
+
+func TestResignAfterOneMoveRefunds(t *testing.T) {
+	for _, resigner := range []string{bob, carol} {
+		t.Run(resigner, func(t *testing.T) {
+			msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+			ctx := sdk.UnwrapSDKContext(context)
+			defer ctrl.Finish()
+			pay := escrow.ExpectPay(context, bob, 45).Times(1)
+			escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
+			msgServer.PlayMove(context, &types.MsgPlayMove{
+				Creator:   bob,
+				GameIndex: "1",
+				FromX:     1,
+				FromY:     2,
+				ToX:       2,
+				ToY:       3,
+			})
+			_, err := msgServer.Resign(context, &types.MsgResign{
+				Creator:   resigner,
+				GameIndex: "1",
+			})
+			require.Nil(t, err)
+			game1, _ := keeper.GetStoredGame(ctx, "1")
+			require.Empty(t, game1.PaidColors)
+		})
+	}
+}
+
+func TestResignNotPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestResignGameFinished(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "game is already finished", err.Error())
+}
+
+func TestResignGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var prize = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+func TestCreateSponsoredGameCollectsPrize(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPayCoins(context, alice, prize).Times(1)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Prize:   prize,
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, alice, game.Sponsor)
+	require.Equal(t, prize, game.Prize)
+	require.True(t, game.PrizeInEscrow)
+	require.Empty(t, game.BlackWager)
+	require.Empty(t, game.RedWager)
+}
+
+func TestCreateSponsoredGameSponsorCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPayCoins(context, alice, prize).Return(errors.New("oops"))
+	response, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Prize:   prize,
+	})
+	require.Nil(t, response)
+	require.EqualError(t, err, "sponsor cannot pay the prize: oops")
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestCreateSponsoredGamePrizeDenomNotAllowed(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithMocksForJoinGame(t)
+	defer ctrl.Finish()
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Prize:   sdk.NewCoins(sdk.NewInt64Coin("silver", 100)),
+	})
+	require.EqualError(t, err, "silver: denom is not accepted for wagers")
+}
+
+func TestSponsoredGameRejectRefundsSponsor(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	pay := escrow.ExpectPayCoins(context, alice, prize).Times(1)
+	escrow.ExpectRefundCoins(context, alice, prize).Times(1).After(pay)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Prize:   prize,
+	})
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.False(t, game.PrizeInEscrow)
+}
+
+func TestSponsoredGameWinnerGetsPrize(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPayCoins(context, alice, prize).Times(1)
+	escrow.EXPECT().SendCoinsFromAccountToModule(ctx, gomock.Any(), types.ModuleName, gomock.Len(0)).Times(2)
+	escrow.ExpectRefundCoins(context, carol, prize).Times(1)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Prize:   prize,
+	})
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{Creator: bob, GameIndex: "1"})
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{Creator: carol, GameIndex: "1"})
+	playTwoMovesForResign(msgServer, ctx)
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "r", game.Winner)
+	require.False(t, game.PrizeInEscrow)
+}
+
+func TestSponsoredGameResignBeforeAnyMoveRefundsSponsor(t *testing.T) {
+	for _, upFrontEscrow := range []bool{false, true} {
+		t.Run(fmt.Sprintf("UpFrontEscrow=%t", upFrontEscrow), func(t *testing.T) {
+			msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+			ctx := sdk.UnwrapSDKContext(context)
+			defer ctrl.Finish()
+			params := keeper.GetParams(ctx)
+			params.UpFrontEscrow = upFrontEscrow
+			keeper.SetParams(ctx, params)
+			pay := escrow.ExpectPayCoins(context, alice, prize).Times(1)
+			escrow.EXPECT().SendCoinsFromAccountToModule(ctx, gomock.Any(), types.ModuleName, gomock.Len(0)).AnyTimes()
+			escrow.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, gomock.Any(), gomock.Len(0)).AnyTimes()
+			escrow.ExpectRefundCoins(context, alice, prize).Times(1).After(pay)
+			msgServer.CreateGame(context, &types.MsgCreateGame{
+				Creator: alice,
+				Black:   bob,
+				Red:     carol,
+				Sponsor: alice,
+				Prize:   prize,
+			})
+			msgServer.AcceptGame(context, &types.MsgAcceptGame{Creator: bob, GameIndex: "1"})
+			msgServer.AcceptGame(context, &types.MsgAcceptGame{Creator: carol, GameIndex: "1"})
+			_, err := msgServer.Resign(context, &types.MsgResign{
+				Creator:   bob,
+				GameIndex: "1",
+			})
+			require.Nil(t, err)
+			game, _ := keeper.GetStoredGame(ctx, "1")
+			require.False(t, game.PrizeInEscrow)
+			require.Empty(t, game.PaidColors)
+		})
+	}
+}
+
+func TestSponsoredGameSponsorClaimsExpiredInvitation(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPayCoins(context, alice, prize).Times(1)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Sponsor: alice,
+		Prize:   prize,
+	})
+	later := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultInvitationDuration + time.Second)))
+	escrow.ExpectRefundCoins(later, alice, prize).Times(1)
+	response, err := msgServer.ClaimTimeout(later, &types.MsgClaimTimeout{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgClaimTimeoutResponse{Winner: "*"}, *response)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game.Status)
+	require.False(t, game.PrizeInEscrow)
+}
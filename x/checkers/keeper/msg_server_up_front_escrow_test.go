@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/testutil/mock_types"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithUpFrontEscrow(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *mock_types.MockBankEscrowKeeper) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := k.GetParams(ctx)
+	params.UpFrontEscrow = true
+	k.SetParams(ctx, params)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	return msgServer, k, context, ctrl, escrow
+}
+
+func TestUpFrontEscrowCollectsBothOnAcceptance(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithUpFrontEscrow(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	pending, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.True(t, pending.UpFrontEscrow)
+	require.Empty(t, pending.PaidColors)
+
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	_, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, []string{"b", "r"}, game.PaidColors)
+
+	playTwoMovesForResign(msgServer, ctx)
+	game, _ = keeper.GetStoredGame(ctx, "1")
+	require.EqualValues(t, 2, game.MoveCount)
+}
+
+func TestUpFrontEscrowAcceptanceFailsWhenCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithUpFrontEscrow(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Return(errors.New("oops"))
+	response, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, response)
+	require.EqualError(t, err, "red cannot pay the wager: oops")
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_PENDING, game.Status)
+	require.Equal(t, []string{carol}, game.Invitees)
+}
+
+func TestUpFrontEscrowCollectsBothOnJoining(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithMocksForJoinGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.UpFrontEscrow = true
+	keeper.SetParams(ctx, params)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Wager:   45,
+		Denom:   "stake",
+	})
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, alice, 45).Times(1)
+	_, err := msgServer.JoinGame(context, &types.MsgJoinGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, []string{"b", "r"}, game.PaidColors)
+}
+
+func TestUpFrontEscrowRejectRefundsBoth(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithUpFrontEscrow(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	rejectContext := sdk.WrapSDKContext(ctx.WithEventManager(sdk.NewEventManager()))
+	escrow.ExpectRefund(rejectContext, bob, 45).Times(1)
+	escrow.ExpectRefund(rejectContext, carol, 45).Times(1)
+	_, err := msgServer.RejectGame(rejectContext, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Empty(t, game.PaidColors)
+}
+
+func TestUpFrontEscrowExpiredRefundsBoth(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithUpFrontEscrow(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultTurnDuration + time.Second))
+	laterContext := sdk.WrapSDKContext(later)
+	escrow.ExpectRefund(laterContext, bob, 45).Times(1)
+	escrow.ExpectRefund(laterContext, carol, 45).Times(1)
+	keeper.ForfeitExpiredGames(laterContext)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.GameStatus_GAME_STATUS_EXPIRED, game.Status)
+	require.Empty(t, game.PaidColors)
+}
+
+func TestUpFrontEscrowResignBeforeAnyMovePaysOpponent(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithUpFrontEscrow(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
+	payCarol := escrow.ExpectPay(context, carol, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 90).Times(1).After(payBob).After(payCarol)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 0, game.MoveCount)
+	require.Equal(t, "r", game.Winner)
+	require.Empty(t, game.PaidColors)
+}
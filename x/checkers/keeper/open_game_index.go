@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddToOpenGameIndex lists the game among those that can be joined
+func (k Keeper) AddToOpenGameIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OpenGameIndexKeyPrefix))
+	store.Set(types.OpenGameIndexKey(storedGame.Index), []byte(storedGame.Index))
+}
+
+// RemoveFromOpenGameIndex removes the game from those that can be joined, if it was there
+func (k Keeper) RemoveFromOpenGameIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OpenGameIndexKeyPrefix))
+	store.Delete(types.OpenGameIndexKey(storedGame.Index))
+}
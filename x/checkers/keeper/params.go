@@ -1,16 +1,131 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.NoProgressMoveLimit(ctx),
+		k.MinTurnDuration(ctx),
+		k.MaxTurnDuration(ctx),
+		k.MinTurnBlocks(ctx),
+		k.MaxTurnBlocks(ctx),
+		k.MaxForfeitsPerBlock(ctx),
+		k.InvitationDuration(ctx),
+		k.UpFrontEscrow(ctx),
+		k.CreateGameGas(ctx),
+		k.PlayMoveGas(ctx),
+		k.RejectGameRefundGas(ctx),
+		k.LeaderboardWinnerLength(ctx),
+		k.Rake(ctx),
+		k.RakeRecipient(ctx),
+		k.GetWagerDenoms(ctx),
+	)
+}
+
+// GetParamsIfExists fills in the params found in store, and leaves the others as they are
+func (k Keeper) GetParamsIfExists(ctx sdk.Context, params *types.Params) {
+	for _, pair := range params.ParamSetPairs() {
+		k.paramstore.GetIfExists(ctx, pair.Key, pair.Value)
+	}
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// NoProgressMoveLimit returns the NoProgressMoveLimit param
+func (k Keeper) NoProgressMoveLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyNoProgressMoveLimit, &res)
+	return
+}
+
+// MinTurnDuration returns the MinTurnDuration param
+func (k Keeper) MinTurnDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMinTurnDuration, &res)
+	return
+}
+
+// MaxTurnDuration returns the MaxTurnDuration param
+func (k Keeper) MaxTurnDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxTurnDuration, &res)
+	return
+}
+
+// MinTurnBlocks returns the MinTurnBlocks param
+func (k Keeper) MinTurnBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMinTurnBlocks, &res)
+	return
+}
+
+// MaxTurnBlocks returns the MaxTurnBlocks param
+func (k Keeper) MaxTurnBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTurnBlocks, &res)
+	return
+}
+
+// MaxForfeitsPerBlock returns the MaxForfeitsPerBlock param
+func (k Keeper) MaxForfeitsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxForfeitsPerBlock, &res)
+	return
+}
+
+// InvitationDuration returns the InvitationDuration param
+func (k Keeper) InvitationDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyInvitationDuration, &res)
+	return
+}
+
+// UpFrontEscrow returns the UpFrontEscrow param
+func (k Keeper) UpFrontEscrow(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyUpFrontEscrow, &res)
+	return
+}
+
+// CreateGameGas returns the CreateGameGas param
+func (k Keeper) CreateGameGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyCreateGameGas, &res)
+	return
+}
+
+// PlayMoveGas returns the PlayMoveGas param
+func (k Keeper) PlayMoveGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPlayMoveGas, &res)
+	return
+}
+
+// RejectGameRefundGas returns the RejectGameRefundGas param
+func (k Keeper) RejectGameRefundGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRejectGameRefundGas, &res)
+	return
+}
+
+// LeaderboardWinnerLength returns the LeaderboardWinnerLength param
+func (k Keeper) LeaderboardWinnerLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLeaderboardWinnerLength, &res)
+	return
+}
+
+// Rake returns the Rake param
+func (k Keeper) Rake(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyRake, &res)
+	return
+}
+
+// RakeRecipient returns the RakeRecipient param
+func (k Keeper) RakeRecipient(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyRakeRecipient, &res)
+	return
+}
+
+// GetWagerDenoms returns the WagerDenoms param
+func (k Keeper) GetWagerDenoms(ctx sdk.Context) (res []types.WagerDenom) {
+	k.paramstore.Get(ctx, types.KeyWagerDenoms, &res)
+	return
+}
@@ -14,6 +14,8 @@ func mustAddDeltaGameResultToPlayer(
 	wonDelta uint64,
 	lostDelta uint64,
 	forfeitDelta uint64,
+	drawnDelta uint64,
+	resignedDelta uint64,
 ) (playerInfo types.PlayerInfo) {
 	playerInfo, found := k.GetPlayerInfo(ctx, player.String())
 	if !found {
@@ -22,25 +24,37 @@ func mustAddDeltaGameResultToPlayer(
 			WonCount:       0,
 			LostCount:      0,
 			ForfeitedCount: 0,
+			DrawnCount:     0,
+			ResignedCount:  0,
 		}
 	}
 	playerInfo.WonCount += wonDelta
 	playerInfo.LostCount += lostDelta
 	playerInfo.ForfeitedCount += forfeitDelta
+	playerInfo.DrawnCount += drawnDelta
+	playerInfo.ResignedCount += resignedDelta
 	k.SetPlayerInfo(ctx, playerInfo)
 	return playerInfo
 }
 
 func (k *Keeper) MustAddWonGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 1, 0, 0)
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 1, 0, 0, 0, 0)
 }
 
 func (k *Keeper) MustAddLostGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 1, 0)
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 1, 0, 0, 0)
 }
 
 func (k *Keeper) MustAddForfeitedGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 1)
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 1, 0, 0)
+}
+
+func (k *Keeper) MustAddDrawnGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 0, 1, 0)
+}
+
+func (k *Keeper) MustAddResignedGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 0, 0, 1)
 }
 
 func getWinnerAndLoserAddress(
@@ -84,3 +98,29 @@ func (k *Keeper) MustRegisterPlayerForfeit(
 	winnerAddress, loserAddress := getWinnerAndLoserAddress(storedGame)
 	return k.MustAddWonGameResultToPlayer(ctx, winnerAddress), k.MustAddForfeitedGameResultToPlayer(ctx, loserAddress)
 }
+
+func (k *Keeper) MustRegisterPlayerResignation(
+	ctx sdk.Context,
+	storedGame *types.StoredGame,
+) (winnerInfo types.PlayerInfo, resignedInfo types.PlayerInfo) {
+	winnerAddress, loserAddress := getWinnerAndLoserAddress(storedGame)
+	return k.MustAddWonGameResultToPlayer(ctx, winnerAddress), k.MustAddResignedGameResultToPlayer(ctx, loserAddress)
+}
+
+func (k *Keeper) MustRegisterPlayerDraw(
+	ctx sdk.Context,
+	storedGame *types.StoredGame,
+) (blackInfo types.PlayerInfo, redInfo types.PlayerInfo) {
+	if storedGame.Winner != rules.PieceStrings[rules.DRAW_PLAYER] {
+		panic(fmt.Sprintf(types.ErrGameNotDrawn.Error(), storedGame.Winner))
+	}
+	blackAddress, err := storedGame.GetBlackAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	redAddress, err := storedGame.GetRedAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	return k.MustAddDrawnGameResultToPlayer(ctx, blackAddress), k.MustAddDrawnGameResultToPlayer(ctx, redAddress)
+}
@@ -2,34 +2,83 @@ package keeper
 
 import (
 	"fmt"
+	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// CollectWager collects the wager of the player to move, unless it is already in escrow, so that only the first
+// move of each player pays.
 func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) error {
-	if storedGame.MoveCount == 0 {
-		black, err := storedGame.GetBlackAddress()
-		if err != nil {
-			panic(err.Error())
-		}
-		err = k.bank.SendCoinsFromAccountToModule(ctx, black, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
-		if err != nil {
-			return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
-		}
-	} else if storedGame.MoveCount == 1 {
-		red, err := storedGame.GetRedAddress()
-		if err != nil {
-			panic(err.Error())
-		}
-		err = k.bank.SendCoinsFromAccountToModule(ctx, red, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+	return k.collectWagerOf(ctx, storedGame, storedGame.Turn)
+}
+
+// CollectJoinWager collects the wager of the player taking the open seat.
+func (k *Keeper) CollectJoinWager(ctx sdk.Context, storedGame *types.StoredGame) error {
+	if storedGame.UpFrontEscrow {
+		return k.CollectUpFrontWagers(ctx, storedGame)
+	}
+	return k.collectWagerOf(ctx, storedGame, storedGame.JoinedColor)
+}
+
+// CollectUpFrontWagers escrows both wagers once a game with up-front escrow is ready to start.
+func (k *Keeper) CollectUpFrontWagers(ctx sdk.Context, storedGame *types.StoredGame) error {
+	if !storedGame.UpFrontEscrow || !storedGame.IsReadyToStart() {
+		return nil
+	}
+	for _, color := range []string{rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]} {
+		err := k.collectWagerOf(ctx, storedGame, color)
 		if err != nil {
-			return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
+			return err
 		}
 	}
 	return nil
 }
 
+// CollectPrize escrows the prize put up by the sponsor, if any.
+func (k *Keeper) CollectPrize(ctx sdk.Context, storedGame *types.StoredGame) error {
+	if storedGame.Sponsor == "" || storedGame.PrizeInEscrow {
+		return nil
+	}
+	sponsor, err := storedGame.GetSponsorAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, sponsor, types.ModuleName, storedGame.Prize)
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrSponsorCannotPay.Error())
+	}
+	storedGame.PrizeInEscrow = true
+	return nil
+}
+
+func (k *Keeper) collectWagerOf(ctx sdk.Context, storedGame *types.StoredGame, color string) error {
+	if storedGame.HasPaid(color) {
+		return nil
+	}
+	var player sdk.AccAddress
+	var err error
+	var cannotPay error
+	if color == rules.PieceStrings[rules.BLACK_PLAYER] {
+		player, err = storedGame.GetBlackAddress()
+		cannotPay = types.ErrBlackCannotPay
+	} else {
+		player, err = storedGame.GetRedAddress()
+		cannotPay = types.ErrRedCannotPay
+	}
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, player, types.ModuleName, storedGame.GetWagerOf(color))
+	if err != nil {
+		return sdkerrors.Wrapf(err, cannotPay.Error())
+	}
+	storedGame.SetPaid(color)
+	return nil
+}
+
 func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
 	winnerAddress, found, err := storedGame.GetWinnerAddress()
 	if err != nil {
@@ -38,30 +87,90 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 	if !found {
 		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Winner))
 	}
-	winnings := storedGame.GetWagerCoin()
-	if storedGame.MoveCount == 0 {
+	if len(storedGame.PaidColors) == 0 && !storedGame.PrizeInEscrow {
 		panic(types.ErrNothingToPay.Error())
-	} else if storedGame.MoveCount > 1 {
-		winnings = winnings.Add(winnings)
 	}
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
+	pot := storedGame.GetPot()
+	rake := k.Rake(ctx)
+	fee := sdk.NewCoins()
+	for _, coin := range pot {
+		fee = fee.Add(sdk.NewCoin(coin.Denom, rake.MulInt(coin.Amount).TruncateInt()))
+	}
+	if !fee.Empty() && !k.tryCollectFee(ctx, fee) {
+		// The winner is better placed to hold the coins than the module's escrow
+		fee = sdk.NewCoins()
+	}
+	winnings := pot.Sub(fee)
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, winnings)
 	if err != nil {
 		panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
 	}
+	storedGame.PaidColors = nil
+	storedGame.PrizeInEscrow = false
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.WinningsPaidEventType,
+			sdk.NewAttribute(types.WinningsPaidEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.WinningsPaidEventWinner, winnerAddress.String()),
+			sdk.NewAttribute(types.WinningsPaidEventWinnings, winnings.String()),
+			sdk.NewAttribute(types.WinningsPaidEventFee, fee.String()),
+		),
+	)
+}
+
+// tryCollectFee sends the rake to its recipient and adds it to the total fees. A fee that cannot be routed
+// is logged and reported as not collected, because games are also settled in EndBlock, which must not panic.
+func (k *Keeper) tryCollectFee(ctx sdk.Context, fee sdk.Coins) bool {
+	var err error
+	if recipient := k.RakeRecipient(ctx); recipient == "" {
+		err = k.distribution.FundCommunityPool(ctx, fee, authtypes.NewModuleAddress(types.ModuleName))
+	} else if k.account.GetModuleAddress(recipient) == nil {
+		err = fmt.Errorf(types.ErrUnknownRakeRecipient.Error(), recipient)
+	} else {
+		err = k.bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient, fee)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf(types.ErrCannotCollectFee.Error(), err.Error()))
+		return false
+	}
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	systemInfo.TotalFees = systemInfo.TotalFees.Add(fee...)
+	k.SetSystemInfo(ctx, systemInfo)
+	return true
 }
 
 func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
-	if storedGame.MoveCount == 1 {
+	if storedGame.HasPaid(rules.PieceStrings[rules.BLACK_PLAYER]) {
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
 			panic(err.Error())
 		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, sdk.NewCoins(storedGame.GetWagerCoin()))
+		k.mustRefundWagerTo(ctx, black, storedGame.BlackWager)
+	}
+	if storedGame.HasPaid(rules.PieceStrings[rules.RED_PLAYER]) {
+		red, err := storedGame.GetRedAddress()
+		if err != nil {
+			panic(err.Error())
+		}
+		k.mustRefundWagerTo(ctx, red, storedGame.RedWager)
+	}
+	if storedGame.PrizeInEscrow {
+		sponsor, err := storedGame.GetSponsorAddress()
 		if err != nil {
-			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+			panic(err.Error())
 		}
-	} else if storedGame.MoveCount != 0 {
-		// TODO Implement a draw mechanism
-		panic(fmt.Sprintf(types.ErrNotInRefundState.Error(), storedGame.MoveCount))
+		k.mustRefundWagerTo(ctx, sponsor, storedGame.Prize)
+	}
+	storedGame.PaidColors = nil
+	storedGame.PrizeInEscrow = false
+}
+
+func (k *Keeper) mustRefundWagerTo(ctx sdk.Context, player sdk.AccAddress, wager sdk.Coins) {
+	err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, player, wager)
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
 	}
 }
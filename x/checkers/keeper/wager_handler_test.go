@@ -12,6 +12,7 @@ import (
 	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 )
 
@@ -34,7 +35,7 @@ func TestWagerHandlerCollectWrongNoBlack(t *testing.T) {
 		require.NotNil(t, r, "The code did not panic")
 		require.Equal(t, "black address is invalid: : empty address string is not allowed", r)
 	}()
-	k.CollectWager(ctx, &types.StoredGame{MoveCount: 0})
+	k.CollectWager(ctx, &types.StoredGame{Turn: "b"})
 }
 
 func TestWagerHandlerCollectFailedNoMove(t *testing.T) {
@@ -46,10 +47,10 @@ func TestWagerHandlerCollectFailedNoMove(t *testing.T) {
 		SendCoinsFromAccountToModule(ctx, black, types.ModuleName, gomock.Any()).
 		Return(errors.New("oops"))
 	err := k.CollectWager(ctx, &types.StoredGame{
-		Black:     alice,
-		MoveCount: 0,
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		Turn:       "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.NotNil(t, err)
 	require.EqualError(t, err, "black cannot pay the wager: oops")
@@ -64,7 +65,7 @@ func TestWagerHandlerCollectWrongNoRed(t *testing.T) {
 		require.NotNil(t, r, "The code did not panic")
 		require.Equal(t, "red address is invalid: : empty address string is not allowed", r)
 	}()
-	k.CollectWager(ctx, &types.StoredGame{MoveCount: 1})
+	k.CollectWager(ctx, &types.StoredGame{Turn: "r"})
 }
 
 func TestWagerHandlerCollectFailedOneMove(t *testing.T) {
@@ -76,10 +77,11 @@ func TestWagerHandlerCollectFailedOneMove(t *testing.T) {
 		SendCoinsFromAccountToModule(ctx, red, types.ModuleName, gomock.Any()).
 		Return(errors.New("oops"))
 	err := k.CollectWager(ctx, &types.StoredGame{
-		Red:       bob,
-		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Red:        bob,
+		Turn:       "r",
+		PaidColors: []string{"b"},
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.NotNil(t, err)
 	require.EqualError(t, err, "red cannot pay the wager: oops")
@@ -91,10 +93,10 @@ func TestWagerHandlerCollectNoMove(t *testing.T) {
 	defer ctrl.Finish()
 	escrow.ExpectPay(context, alice, 45).Times(1)
 	err := k.CollectWager(ctx, &types.StoredGame{
-		Black:     alice,
-		MoveCount: 0,
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		Turn:       "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 }
@@ -105,10 +107,11 @@ func TestWagerHandlerCollectOneMove(t *testing.T) {
 	defer ctrl.Finish()
 	escrow.ExpectPay(context, bob, 45).Times(1)
 	err := k.CollectWager(ctx, &types.StoredGame{
-		Red:       bob,
-		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Red:        bob,
+		Turn:       "r",
+		PaidColors: []string{"b"},
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 }
@@ -174,12 +177,13 @@ func TestWagerHandlerPayWrongEscrowFailed(t *testing.T) {
 		require.Equal(t, "cannot pay winnings to winner: oops", r)
 	}()
 	k.MustPayWinnings(ctx, &types.StoredGame{
-		Black:     alice,
-		Red:       bob,
-		MoveCount: 2,
-		Winner:    "b",
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -189,12 +193,13 @@ func TestWagerHandlerPayEscrowCalledOneMove(t *testing.T) {
 	defer ctrl.Finish()
 	escrow.ExpectRefund(context, alice, 45).Times(1)
 	k.MustPayWinnings(ctx, &types.StoredGame{
-		Black:     alice,
-		Red:       bob,
-		MoveCount: 1,
-		Winner:    "b",
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  1,
+		PaidColors: []string{"b"},
+		Winner:     "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -204,26 +209,48 @@ func TestWagerHandlerPayEscrowCalledTwoMoves(t *testing.T) {
 	defer ctrl.Finish()
 	escrow.ExpectRefund(context, alice, 90).Times(1)
 	k.MustPayWinnings(ctx, &types.StoredGame{
-		Black:     alice,
-		Red:       bob,
-		MoveCount: 2,
-		Winner:    "b",
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
-func TestWagerHandlerRefundWrongManyMoves(t *testing.T) {
-	k, context, ctrl, _ := setupKeeperForWagerHandler(t)
+func TestWagerHandlerRefundBothManyMoves(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 45).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	k.MustRefundWager(ctx, &types.StoredGame{
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+}
+
+func TestWagerHandlerRefundWrongNoRed(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 45).Times(1)
 	defer func() {
 		r := recover()
 		require.NotNil(t, r, "The code did not panic!")
-		require.Equal(t, "game is not in a state to refund, move count: 2", r)
+		require.EqualValues(t, "red address is invalid: : empty address string is not allowed", r)
 	}()
 	k.MustRefundWager(ctx, &types.StoredGame{
-		MoveCount: 2,
+		Black:      alice,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -243,7 +270,7 @@ func TestWagerHandlerRefundWrongNoBlack(t *testing.T) {
 		require.NotNil(t, r, "The code did not panic!")
 		require.EqualValues(t, "black address is invalid: : empty address string is not allowed", r)
 	}()
-	k.MustRefundWager(ctx, &types.StoredGame{MoveCount: 1})
+	k.MustRefundWager(ctx, &types.StoredGame{MoveCount: 1, PaidColors: []string{"b"}})
 }
 
 func TestWagerHandlerRefundWrongEscrowFailed(t *testing.T) {
@@ -259,10 +286,11 @@ func TestWagerHandlerRefundWrongEscrowFailed(t *testing.T) {
 		require.EqualValues(t, "cannot refund wager to: oops", r)
 	}()
 	k.MustRefundWager(ctx, &types.StoredGame{
-		Black:     alice,
-		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		MoveCount:  1,
+		PaidColors: []string{"b"},
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -272,9 +300,264 @@ func TestWagerHandlerRefundCalled(t *testing.T) {
 	defer ctrl.Finish()
 	escrow.ExpectRefund(context, alice, 45).Times(1)
 	k.MustRefundWager(ctx, &types.StoredGame{
-		Black:     alice,
-		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Black:      alice,
+		MoveCount:  1,
+		PaidColors: []string{"b"},
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+}
+
+func setupKeeperForWagerHandlerWithRake(t testing.TB, rake string, recipient string) (keeper.Keeper,
+	goContext.Context, *gomock.Controller, *mock_types.MockBankEscrowKeeper, *mock_types.MockDistributionKeeper) {
+	k, context, ctrl, bankMock, distributionMock, _ := setupKeeperForWagerHandlerWithRakeRecipient(t, rake, recipient)
+	return k, context, ctrl, bankMock, distributionMock
+}
+
+func setupKeeperForWagerHandlerWithRakeRecipient(t testing.TB, rake string, recipient string) (keeper.Keeper,
+	goContext.Context, *gomock.Controller, *mock_types.MockBankEscrowKeeper, *mock_types.MockDistributionKeeper,
+	*mock_types.MockAccountKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := mock_types.NewMockBankEscrowKeeper(ctrl)
+	distributionMock := mock_types.NewMockDistributionKeeper(ctrl)
+	accountMock := mock_types.NewMockAccountKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithAccountMocks(t, bankMock, distributionMock, accountMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	params := k.GetParams(ctx)
+	params.Rake = sdk.MustNewDecFromStr(rake)
+	params.RakeRecipient = recipient
+	k.SetParams(ctx, params)
+	context := sdk.WrapSDKContext(ctx)
+	return *k, context, ctrl, bankMock, distributionMock, accountMock
+}
+
+func TestWagerHandlerPayRakeToCommunityPool(t *testing.T) {
+	k, context, ctrl, escrow, distribution := setupKeeperForWagerHandlerWithRake(t, "0.1", "")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 81).Times(1)
+	distribution.EXPECT().
+		FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), authtypes.NewModuleAddress(types.ModuleName)).
+		Times(1)
+	storedGame := types.StoredGame{
+		Index:      "1",
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	}
+	k.MustPayWinnings(ctx, &storedGame)
+	require.Empty(t, storedGame.PaidColors)
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), systemInfo.TotalFees)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: types.WinningsPaidEventType,
+		Attributes: []sdk.Attribute{
+			{Key: types.WinningsPaidEventGameIndex, Value: "1"},
+			{Key: types.WinningsPaidEventWinner, Value: alice},
+			{Key: types.WinningsPaidEventWinnings, Value: "81stake"},
+			{Key: types.WinningsPaidEventFee, Value: "9stake"},
+		},
+	}, events[0])
+}
+
+func TestWagerHandlerPayRakeToModuleAccount(t *testing.T) {
+	k, context, ctrl, escrow, _, account := setupKeeperForWagerHandlerWithRakeRecipient(t, "0.333", "fee_collector")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	account.EXPECT().GetModuleAddress("fee_collector").Return(authtypes.NewModuleAddress("fee_collector"))
+	escrow.ExpectRefund(context, bob, 61).Times(1)
+	escrow.EXPECT().
+		SendCoinsFromModuleToModule(ctx, types.ModuleName, "fee_collector", sdk.NewCoins(sdk.NewInt64Coin("stake", 29))).
+		Times(1)
+	k.MustPayWinnings(ctx, &types.StoredGame{
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "r",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 29)), systemInfo.TotalFees)
+}
+
+func TestWagerHandlerPayRakeTooSmallToCollect(t *testing.T) {
+	k, context, ctrl, escrow, _ := setupKeeperForWagerHandlerWithRake(t, "0.01", "")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 90).Times(1)
+	k.MustPayWinnings(ctx, &types.StoredGame{
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	require.Empty(t, systemInfo.TotalFees)
+}
+
+func TestWagerHandlerPayRakeFailedPaysWinnerInFull(t *testing.T) {
+	k, context, ctrl, escrow, distribution := setupKeeperForWagerHandlerWithRake(t, "0.1", "")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	distribution.EXPECT().FundCommunityPool(ctx, gomock.Any(), gomock.Any()).Return(errors.New("oops"))
+	escrow.ExpectRefund(context, alice, 90).Times(1)
+	k.MustPayWinnings(ctx, &types.StoredGame{
+		Index:      "1",
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "b",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	require.Empty(t, systemInfo.TotalFees)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: types.WinningsPaidEventGameIndex, Value: "1"},
+		{Key: types.WinningsPaidEventWinner, Value: alice},
+		{Key: types.WinningsPaidEventWinnings, Value: "90stake"},
+		{Key: types.WinningsPaidEventFee, Value: ""},
+	}, events[0].Attributes)
+}
+
+func TestWagerHandlerPayRakeToUnknownModulePaysWinnerInFull(t *testing.T) {
+	k, context, ctrl, escrow, _, account := setupKeeperForWagerHandlerWithRakeRecipient(t, "0.1", "no_such_module")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	account.EXPECT().GetModuleAddress("no_such_module").Return(nil)
+	escrow.ExpectRefund(context, bob, 90).Times(1)
+	k.MustPayWinnings(ctx, &types.StoredGame{
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "r",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	systemInfo, _ := k.GetSystemInfo(ctx)
+	require.Empty(t, systemInfo.TotalFees)
+}
+
+func TestWagerHandlerCollectAsymmetric(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	blackWager := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3))
+	redWager := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	pay := escrow.ExpectPayCoins(context, alice, blackWager).Times(1)
+	escrow.ExpectPayCoins(context, bob, redWager).Times(1).After(pay)
+	storedGame := types.StoredGame{
+		Black:      alice,
+		Red:        bob,
+		Turn:       "b",
+		BlackWager: blackWager,
+		RedWager:   redWager,
+	}
+	require.Nil(t, k.CollectWager(ctx, &storedGame))
+	storedGame.Turn = "r"
+	require.Nil(t, k.CollectWager(ctx, &storedGame))
+	storedGame.Turn = "b"
+	require.Nil(t, k.CollectWager(ctx, &storedGame))
+	require.Equal(t, []string{"b", "r"}, storedGame.PaidColors)
+}
+
+func TestWagerHandlerPayAsymmetricWithRake(t *testing.T) {
+	k, context, ctrl, escrow, distribution := setupKeeperForWagerHandlerWithRake(t, "0.1", "")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefundCoins(context, bob,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 36), sdk.NewInt64Coin("token", 3))).Times(1)
+	distribution.EXPECT().
+		FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), authtypes.NewModuleAddress(types.ModuleName)).
+		Times(1)
+	k.MustPayWinnings(ctx, &types.StoredGame{
+		Index:      "1",
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		Winner:     "r",
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: types.WinningsPaidEventGameIndex, Value: "1"},
+		{Key: types.WinningsPaidEventWinner, Value: bob},
+		{Key: types.WinningsPaidEventWinnings, Value: "36stake,3token"},
+		{Key: types.WinningsPaidEventFee, Value: "4stake"},
+	}, events[0].Attributes)
+}
+
+func TestWagerHandlerRefundAsymmetric(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	blackWager := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3))
+	redWager := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	refund := escrow.ExpectRefundCoins(context, alice, blackWager).Times(1)
+	escrow.ExpectRefundCoins(context, bob, redWager).Times(1).After(refund)
+	k.MustRefundWager(ctx, &types.StoredGame{
+		Black:      alice,
+		Red:        bob,
+		MoveCount:  2,
+		PaidColors: []string{"b", "r"},
+		BlackWager: blackWager,
+		RedWager:   redWager,
+	})
+}
+
+func TestWagerHandlerRefundSponsoredPrize(t *testing.T) {
+	k, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	prize := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	escrow.ExpectRefundCoins(context, carol, prize).Times(1)
+	storedGame := types.StoredGame{
+		Black:         alice,
+		Red:           bob,
+		Sponsor:       carol,
+		Prize:         prize,
+		PrizeInEscrow: true,
+	}
+	k.MustRefundWager(ctx, &storedGame)
+	require.False(t, storedGame.PrizeInEscrow)
+}
+
+func TestWagerHandlerPaySponsoredPrizeWithRake(t *testing.T) {
+	k, context, ctrl, escrow, distribution := setupKeeperForWagerHandlerWithRake(t, "0.1", "")
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, alice, 90).Times(1)
+	distribution.EXPECT().
+		FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), authtypes.NewModuleAddress(types.ModuleName)).
+		Times(1)
+	k.MustPayWinnings(ctx, &types.StoredGame{
+		Black:         alice,
+		Red:           bob,
+		MoveCount:     2,
+		PaidColors:    []string{"b", "r"},
+		Winner:        "b",
+		Sponsor:       carol,
+		Prize:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		PrizeInEscrow: true,
 	})
 }
@@ -1,8 +1,8 @@
 package v1tov2
 
-import "github.com/alice/checkers/x/checkers/types"
-
 const (
 	StoredGameChunkSize = 1_000
-	PlayerInfoChunkSize = types.LeaderboardWinnerLength * 2
+	// LeaderboardWinnerLength is the length of the v2 leaderboard, before it became a param
+	LeaderboardWinnerLength = uint64(100)
+	PlayerInfoChunkSize     = LeaderboardWinnerLength * 2
 )
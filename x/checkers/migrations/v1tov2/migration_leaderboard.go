@@ -14,8 +14,8 @@ func addParsedCandidatesAndSort(
 ) []types.WinningPlayerParsed {
 	updated := append(parsedWinners, candidates...)
 	types.SortWinners(updated)
-	if types.LeaderboardWinnerLength < uint64(len(updated)) {
-		updated = updated[:types.LeaderboardWinnerLength]
+	if LeaderboardWinnerLength < uint64(len(updated)) {
+		updated = updated[:LeaderboardWinnerLength]
 	}
 	return updated
 }
@@ -53,7 +53,7 @@ func handlePlayerInfosChannel(
 	done chan<- bool,
 	chunk uint64,
 ) {
-	winners := make([]types.WinningPlayerParsed, 0, types.LeaderboardWinnerLength+chunk)
+	winners := make([]types.WinningPlayerParsed, 0, LeaderboardWinnerLength+chunk)
 	for receivedInfo := range playerInfosChannel {
 		if receivedInfo != nil {
 			winners = AddCandidatesAndSort(winners, ctx, receivedInfo)
@@ -0,0 +1,5 @@
+package v2tov3
+
+const (
+	StoredGameChunkSize = 1_000
+)
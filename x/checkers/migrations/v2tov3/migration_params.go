@@ -0,0 +1,12 @@
+package v2tov3
+
+import (
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// v2 had no params in store, so reading any of them would panic.
+func MigrateParams(ctx sdk.Context, k keeper.Keeper) {
+	k.SetParams(ctx, types.DefaultParams())
+}
@@ -0,0 +1,96 @@
+package v2tov3
+
+import (
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// v2 did not record which piece was in the middle of a multi-jump. When a single piece of the player to move
+// can capture, mandatory capture already forces that piece, so it is safe to mark it. When several pieces can
+// capture, there is no way to tell which one jumped last, so the game is left unconstrained.
+func migrateMustJumpFrom(storedGame *types.StoredGame) error {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return err
+	}
+	jumpingFrom := rules.NO_POS
+	for _, move := range game.LegalMoves() {
+		if !move.IsJump() {
+			break
+		}
+		if jumpingFrom != rules.NO_POS && jumpingFrom != move.Src() {
+			return nil
+		}
+		jumpingFrom = move.Src()
+	}
+	storedGame.SetMustJumpFromPos(jumpingFrom)
+	return nil
+}
+
+// v2 wiped the board of finished games and did not say how they ended. A timeout cannot be told apart from a
+// win on the board after the fact, so the outcome is left as unknown.
+func migrateOutcome(storedGame *types.StoredGame) {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		storedGame.Outcome = types.Outcome_OUTCOME_UNKNOWN
+	}
+}
+
+// v2 only knew whether a game had a winner, and deleted rejected and expired games. A forfeit cannot be told
+// apart from a win, so all games with a winner become finished.
+func migrateStatus(storedGame *types.StoredGame) {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		storedGame.Status = types.GameStatus_GAME_STATUS_FINISHED
+	} else {
+		storedGame.Status = storedGame.GetPlayingStatus()
+	}
+}
+
+// v2 games all had the same turn duration, which is now the default one.
+func migrateTurnDuration(storedGame *types.StoredGame) {
+	storedGame.TurnDuration = types.DefaultTurnDuration
+}
+
+func migrateStoredGame(storedGame *types.StoredGame) error {
+	migrateOutcome(storedGame)
+	migrateStatus(storedGame)
+	migrateTurnDuration(storedGame)
+	return migrateMustJumpFrom(storedGame)
+}
+
+func MapStoredGamesMigrate(ctx sdk.Context, k keeper.Keeper, chunk uint64) error {
+	context := sdk.WrapSDKContext(ctx)
+	var nextKey []byte
+	for {
+		response, err := k.StoredGameAll(context, &types.QueryAllStoredGameRequest{
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: chunk,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		for _, storedGame := range response.StoredGame {
+			err = migrateStoredGame(&storedGame)
+			if err != nil {
+				return err
+			}
+			k.SetStoredGame(ctx, storedGame)
+			// Replaces the FIFO
+			if storedGame.Status.IsOngoing() {
+				k.AddToDeadlineIndex(ctx, storedGame)
+			}
+		}
+		nextKey = response.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package v3
+
+const (
+	TargetConsensusVersion = 4
+)
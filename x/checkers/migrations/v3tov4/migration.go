@@ -0,0 +1,22 @@
+package v3tov4
+
+import (
+	"github.com/alice/checkers/x/checkers/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func PerformMigration(ctx sdk.Context, k keeper.Keeper, storedGameChunk uint64) error {
+	ctx.Logger().Info("Start to set missing checkers params...")
+	MigrateParams(ctx, k)
+	ctx.Logger().Info("Checkers params set")
+	ctx.Logger().Info("Start to migrate checkers stored games...")
+	err := MapStoredGamesMigrate(ctx, k, storedGameChunk)
+	if err != nil {
+		return err
+	}
+	ctx.Logger().Info("Checkers stored games migration done")
+	ctx.Logger().Info("Start to count indexed checkers games...")
+	k.RecountDeadlineIndex(ctx)
+	ctx.Logger().Info("Indexed checkers games counted")
+	return nil
+}
@@ -0,0 +1,15 @@
+package v3tov4
+
+import (
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// v3 params added since the last upgrade are missing from store, and reading them would panic. The params
+// already set, possibly by governance, are kept.
+func MigrateParams(ctx sdk.Context, k keeper.Keeper) {
+	params := types.DefaultParams()
+	k.GetParamsIfExists(ctx, &params)
+	k.SetParams(ctx, params)
+}
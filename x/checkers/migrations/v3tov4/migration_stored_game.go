@@ -0,0 +1,63 @@
+package v3tov4
+
+import (
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// v3 inferred the escrowed wagers from the move count and the joined seat. In-flight games keep collecting the
+// missing wagers on the first moves, as they did when they were created. Finished games have nothing left in
+// escrow.
+func migratePaidColors(storedGame *types.StoredGame) {
+	if !storedGame.Status.IsOngoing() {
+		return
+	}
+	black := rules.PieceStrings[rules.BLACK_PLAYER]
+	red := rules.PieceStrings[rules.RED_PLAYER]
+	if storedGame.JoinedColor == black || storedGame.MoveCount > 0 {
+		storedGame.SetPaid(black)
+	}
+	if storedGame.JoinedColor == red || storedGame.MoveCount > 1 {
+		storedGame.SetPaid(red)
+	}
+}
+
+// v3 staked the same wager and denom on both sides. The coins of each player now carry what is escrowed. A denom
+// that v3 let through without validation could never be collected, so there is nothing to carry over.
+func migrateWagers(storedGame *types.StoredGame) {
+	if !storedGame.BlackWager.Empty() || !storedGame.RedWager.Empty() || sdk.ValidateDenom(storedGame.Denom) != nil {
+		return
+	}
+	wager := sdk.NewCoins(sdk.NewCoin(storedGame.Denom, sdk.NewIntFromUint64(storedGame.Wager)))
+	storedGame.BlackWager = wager
+	storedGame.RedWager = wager
+}
+
+func MapStoredGamesMigrate(ctx sdk.Context, k keeper.Keeper, chunk uint64) error {
+	context := sdk.WrapSDKContext(ctx)
+	var nextKey []byte
+	for {
+		response, err := k.StoredGameAll(context, &types.QueryAllStoredGameRequest{
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: chunk,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		for _, storedGame := range response.StoredGame {
+			migratePaidColors(&storedGame)
+			migrateWagers(&storedGame)
+			k.SetStoredGame(ctx, storedGame)
+		}
+		nextKey = response.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	return nil
+}
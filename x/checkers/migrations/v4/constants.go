@@ -0,0 +1,5 @@
+package v4
+
+const (
+	TargetConsensusVersion = 5
+)
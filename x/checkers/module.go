@@ -7,6 +7,10 @@ import (
 	v1 "github.com/alice/checkers/x/checkers/migrations/v1"
 	"github.com/alice/checkers/x/checkers/migrations/v1tov2"
 	v2 "github.com/alice/checkers/x/checkers/migrations/v2"
+	"github.com/alice/checkers/x/checkers/migrations/v2tov3"
+	v3 "github.com/alice/checkers/x/checkers/migrations/v3"
+	"github.com/alice/checkers/x/checkers/migrations/v3tov4"
+	v4 "github.com/alice/checkers/x/checkers/migrations/v4"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -148,6 +152,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}); err != nil {
 		panic(fmt.Errorf("failed to register migration of %s to v2: %w", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, v2.TargetConsensusVersion, func(ctx sdk.Context) error {
+		return v2tov3.PerformMigration(ctx, am.keeper, v2tov3.StoredGameChunkSize)
+	}); err != nil {
+		panic(fmt.Errorf("failed to register migration of %s to v3: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, v3.TargetConsensusVersion, func(ctx sdk.Context) error {
+		return v3tov4.PerformMigration(ctx, am.keeper, v3tov4.StoredGameChunkSize)
+	}); err != nil {
+		panic(fmt.Errorf("failed to register migration of %s to v4: %w", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -172,7 +186,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return v2.TargetConsensusVersion }
+func (AppModule) ConsensusVersion() uint64 { return v4.TargetConsensusVersion }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
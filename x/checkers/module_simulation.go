@@ -1,7 +1,9 @@
 package checkers
 
 import (
+	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/alice/checkers/testutil/sample"
 	checkerssimulation "github.com/alice/checkers/x/checkers/simulation"
@@ -36,6 +38,38 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRejectGame int = 100
 
+	opWeightMsgPlayMoveSequence = "op_weight_msg_play_move_sequence"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPlayMoveSequence int = 100
+
+	opWeightMsgOfferDraw = "op_weight_msg_offer_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgOfferDraw int = 100
+
+	opWeightMsgAcceptDraw = "op_weight_msg_accept_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptDraw int = 100
+
+	opWeightMsgDeclineDraw = "op_weight_msg_decline_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeclineDraw int = 100
+
+	opWeightMsgResign = "op_weight_msg_resign"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResign int = 100
+
+	opWeightMsgClaimTimeout = "op_weight_msg_claim_timeout"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgClaimTimeout int = 100
+
+	opWeightMsgJoinGame = "op_weight_msg_join_game"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgJoinGame int = 100
+
+	opWeightMsgAcceptGame = "op_weight_msg_accept_game"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptGame int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -59,8 +93,36 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 
 // RandomizedParams creates randomized  param changes for the simulator
 func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
-
-	return []simtypes.ParamChange{}
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCreateGameGas),
+			func(r *rand.Rand) string {
+				// Stays above the largest refund on rejection
+				return fmt.Sprintf("\"%d\"", simtypes.RandIntBetween(r, 10_000, 30_000))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyPlayMoveGas),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", simtypes.RandIntBetween(r, 0, 5_000))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRejectGameRefundGas),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", simtypes.RandIntBetween(r, 0, 10_000))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxTurnDuration),
+			func(r *rand.Rand) string {
+				// Stays above the default min turn duration and the default turn duration
+				days := simtypes.RandIntBetween(r, 1, 57)
+				return fmt.Sprintf("\"%d\"", time.Duration(days)*24*time.Hour)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyLeaderboardWinnerLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", simtypes.RandIntBetween(r, 1, 200))
+			},
+		),
+	}
 }
 
 // RegisterStoreDecoder registers a decoder
@@ -103,6 +165,94 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		checkerssimulation.SimulateMsgRejectGame(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgPlayMoveSequence int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgPlayMoveSequence, &weightMsgPlayMoveSequence, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlayMoveSequence = defaultWeightMsgPlayMoveSequence
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPlayMoveSequence,
+		checkerssimulation.SimulateMsgPlayMoveSequence(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgOfferDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgOfferDraw, &weightMsgOfferDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgOfferDraw = defaultWeightMsgOfferDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgOfferDraw,
+		checkerssimulation.SimulateMsgOfferDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptDraw, &weightMsgAcceptDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptDraw = defaultWeightMsgAcceptDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptDraw,
+		checkerssimulation.SimulateMsgAcceptDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeclineDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDeclineDraw, &weightMsgDeclineDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeclineDraw = defaultWeightMsgDeclineDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeclineDraw,
+		checkerssimulation.SimulateMsgDeclineDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgResign int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResign, &weightMsgResign, nil,
+		func(_ *rand.Rand) {
+			weightMsgResign = defaultWeightMsgResign
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResign,
+		checkerssimulation.SimulateMsgResign(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgClaimTimeout int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgClaimTimeout, &weightMsgClaimTimeout, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimTimeout = defaultWeightMsgClaimTimeout
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimTimeout,
+		checkerssimulation.SimulateMsgClaimTimeout(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgJoinGame int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgJoinGame, &weightMsgJoinGame, nil,
+		func(_ *rand.Rand) {
+			weightMsgJoinGame = defaultWeightMsgJoinGame
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgJoinGame,
+		checkerssimulation.SimulateMsgJoinGame(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptGame int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptGame, &weightMsgAcceptGame, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptGame = defaultWeightMsgAcceptGame
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptGame,
+		checkerssimulation.SimulateMsgAcceptGame(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
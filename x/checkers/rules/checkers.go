@@ -2,8 +2,11 @@ package rules
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -27,6 +30,7 @@ var PieceStrings = map[Player]string{
 	RED_PLAYER:   "r",
 	BLACK_PLAYER: "b",
 	NO_PLAYER:    "*",
+	DRAW_PLAYER:  "d",
 }
 
 var NO_PIECE = Piece{NO_PLAYER, false}
@@ -52,6 +56,11 @@ var NO_PLAYER = Player{
 	Color: "NO_PLAYER",
 }
 
+// DRAW_PLAYER is the winner of a game that ended without a winner.
+var DRAW_PLAYER = Player{
+	Color: "DRAW",
+}
+
 var Players = map[string]Player{
 	RED:   RED_PLAYER,
 	BLACK: BLACK_PLAYER,
@@ -68,6 +77,26 @@ var Jumps = map[Player]map[Pos]map[Pos]Pos{}
 var KingMoves = map[Pos]map[Pos]bool{}
 var KingJumps = map[Pos]map[Pos]Pos{}
 
+// Move is a complete turn for a single piece. Path starts with the source position and lists every
+// landing square in order, so a multi-jump has more than 2 positions. Captured lists the positions of the
+// opponent pieces taken on the way, in the same order.
+type Move struct {
+	Path     []Pos
+	Captured []Pos
+}
+
+func (move Move) Src() Pos {
+	return move.Path[0]
+}
+
+func (move Move) Dst() Pos {
+	return move.Path[len(move.Path)-1]
+}
+
+func (move Move) IsJump() bool {
+	return len(move.Captured) > 0
+}
+
 func Capture(src, dst Pos) Pos {
 	return Pos{(src.X + dst.X) / 2, (src.Y + dst.Y) / 2}
 }
@@ -117,15 +146,25 @@ func init() {
 type Game struct {
 	Pieces map[Pos]Piece
 	Turn   Player
+	// MustJumpFrom is the position of the piece that has to continue its multi-jump, or NO_POS.
+	MustJumpFrom Pos
 }
 
 func New() *Game {
 	pieces := make(map[Pos]Piece)
-	game := &Game{pieces, BLACK_PLAYER}
+	game := &Game{pieces, BLACK_PLAYER, NO_POS}
 	game.addInitialPieces()
 	return game
 }
 
+func (game *Game) Copy() *Game {
+	pieces := make(map[Pos]Piece, len(game.Pieces))
+	for pos, piece := range game.Pieces {
+		pieces[pos] = piece
+	}
+	return &Game{pieces, game.Turn, game.MustJumpFrom}
+}
+
 func (game *Game) addInitialPieces() {
 	for pos := range Usable {
 		if pos.Y >= 0 && pos.Y < 3 {
@@ -162,9 +201,22 @@ func (game *Game) Winner() Player {
 	} else if red_count > 0 && black_count <= 0 {
 		return RED_PLAYER
 	}
+	// The player who cannot move loses
+	if opponent, found := Opponents[game.Turn]; found && !game.playerHasMove(game.Turn) {
+		return opponent
+	}
 	return NO_PLAYER
 }
 
+func (game *Game) HasPieces(player Player) bool {
+	for _, piece := range game.Pieces {
+		if piece.Player == player {
+			return true
+		}
+	}
+	return false
+}
+
 func (game *Game) ValidMove(src, dst Pos) bool {
 	if !game.PieceAt(src) || game.PieceAt(dst) {
 		return false
@@ -204,29 +256,45 @@ func (game *Game) kingPiece(dst Pos) {
 
 func (game *Game) updateTurn(dst Pos, jumped bool) {
 	opponent := Opponents[game.Turn]
-	if (!jumped || !game.jumpPossibleFrom(dst)) && game.playerHasMove(opponent) {
-		game.Turn = opponent
+	if jumped && game.jumpPossibleFrom(dst) {
+		game.MustJumpFrom = dst
+		return
 	}
+	game.MustJumpFrom = NO_POS
+	game.Turn = opponent
+}
+
+func moveTargets(piece Piece, src Pos) map[Pos]bool {
+	if piece.King {
+		return KingMoves[src]
+	}
+	return Moves[piece.Player][src]
+}
+
+func jumpTargets(piece Piece, src Pos) map[Pos]Pos {
+	if piece.King {
+		return KingJumps[src]
+	}
+	return Jumps[piece.Player][src]
+}
+
+func sortPositions(positions []Pos) {
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Y != positions[j].Y {
+			return positions[i].Y < positions[j].Y
+		}
+		return positions[i].X < positions[j].X
+	})
 }
 
 func (game *Game) jumpPossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		// enumerate all player jumps and return true if one is valid
-		for dst := range Jumps[piece.Player][src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	} else {
-		// enumerate all king jumps and return true if one is valid
-		for dst := range KingJumps[src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
+	// enumerate all piece jumps and return true if one is valid
+	for dst := range jumpTargets(game.Pieces[src], src) {
+		if game.ValidJump(src, dst) {
+			return true
 		}
 	}
 	return false
@@ -236,18 +304,9 @@ func (game *Game) movePossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		for dst := range Moves[piece.Player][src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
-	} else {
-		for dst := range KingMoves[src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
+	for dst := range moveTargets(game.Pieces[src], src) {
+		if game.ValidMove(src, dst) {
+			return true
 		}
 	}
 	return false
@@ -271,6 +330,18 @@ func (game *Game) playerHasJump(player Player) bool {
 	return false
 }
 
+// movePiece moves the piece without any validation and returns the captured position, if any.
+func (game *Game) movePiece(src, dst Pos) (captured Pos) {
+	captured = NO_POS
+	if game.ValidJump(src, dst) {
+		captured = Capture(src, dst)
+		delete(game.Pieces, captured)
+	}
+	game.Pieces[dst] = game.Pieces[src]
+	delete(game.Pieces, src)
+	return captured
+}
+
 func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	captured = NO_POS
 	err = nil
@@ -283,23 +354,96 @@ func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	if !game.TurnIs(game.Pieces[src].Player) {
 		return NO_POS, errors.New(fmt.Sprintf("Not %v's turn", game.Pieces[src].Player))
 	}
+	if game.MustJumpFrom != NO_POS && game.MustJumpFrom != src {
+		return NO_POS, errors.New(fmt.Sprintf("Must continue jumping with piece at: %v", game.MustJumpFrom))
+	}
 	if !game.ValidMove(src, dst) {
 		return NO_POS, errors.New(fmt.Sprintf("Invalid move: %v to %v", src, dst))
 	}
-	if game.ValidJump(src, dst) {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
-		captured = Capture(src, dst)
-		delete(game.Pieces, captured)
-	} else {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
-	}
+	captured = game.movePiece(src, dst)
 	game.updateTurn(dst, captured != NO_POS)
 	game.kingPiece(dst)
 	return
 }
 
+// LegalMoves returns every complete move the player whose turn it is can play, ordered by source position.
+// When a capture is available, only capture sequences are returned.
+func (game *Game) LegalMoves() []Move {
+	moves := []Move{}
+	for y := 0; y < BOARD_DIM; y++ {
+		for x := 0; x < BOARD_DIM; x++ {
+			pos := Pos{X: x, Y: y}
+			if game.PieceAt(pos) && game.TurnIs(game.Pieces[pos].Player) {
+				moves = append(moves, game.LegalMovesFrom(pos)...)
+			}
+		}
+	}
+	return moves
+}
+
+// LegalMovesFrom returns every complete move the piece at src can play. It is empty if there is no piece
+// at src, if it is not this piece's turn, or if another piece has to capture or continue its multi-jump instead.
+func (game *Game) LegalMovesFrom(src Pos) []Move {
+	moves := []Move{}
+	if !game.PieceAt(src) {
+		return moves
+	}
+	piece := game.Pieces[src]
+	if !game.TurnIs(piece.Player) {
+		return moves
+	}
+	if game.MustJumpFrom != NO_POS && game.MustJumpFrom != src {
+		return moves
+	}
+	if game.playerHasJump(piece.Player) {
+		return game.jumpSequencesFrom(Move{Path: []Pos{src}, Captured: []Pos{}})
+	}
+	targets := make([]Pos, 0, len(moveTargets(piece, src)))
+	for dst := range moveTargets(piece, src) {
+		targets = append(targets, dst)
+	}
+	sortPositions(targets)
+	for _, dst := range targets {
+		if game.ValidMove(src, dst) {
+			moves = append(moves, Move{Path: []Pos{src, dst}, Captured: []Pos{}})
+		}
+	}
+	return moves
+}
+
+// jumpSequencesFrom extends the partial jump sequence until the piece can no longer capture.
+func (game *Game) jumpSequencesFrom(sequence Move) []Move {
+	sequences := []Move{}
+	src := sequence.Dst()
+	if !game.PieceAt(src) {
+		return sequences
+	}
+	targets := make([]Pos, 0, len(jumpTargets(game.Pieces[src], src)))
+	for dst := range jumpTargets(game.Pieces[src], src) {
+		targets = append(targets, dst)
+	}
+	sortPositions(targets)
+	for _, dst := range targets {
+		if !game.ValidJump(src, dst) {
+			continue
+		}
+		next := game.Copy()
+		captured := next.movePiece(src, dst)
+		extended := Move{
+			Path:     append(append(make([]Pos, 0, len(sequence.Path)+1), sequence.Path...), dst),
+			Captured: append(append(make([]Pos, 0, len(sequence.Captured)+1), sequence.Captured...), captured),
+		}
+		// Same condition as updateTurn, which looks at the piece before Move crowns it. A man that reaches the
+		// far row has no jump left from there, so its sequence ends on arrival.
+		if next.jumpPossibleFrom(dst) {
+			sequences = append(sequences, next.jumpSequencesFrom(extended)...)
+		} else {
+			sequences = append(sequences, extended)
+		}
+	}
+	return sequences
+}
+
 func (game *Game) String() string {
 	var buf bytes.Buffer
 	for y := 0; y < BOARD_DIM; y++ {
@@ -323,6 +467,13 @@ func (game *Game) String() string {
 	return buf.String()
 }
 
+// PositionHash identifies the pieces on the board together with the player to move, so that repeated positions
+// can be detected without keeping whole boards.
+func (game *Game) PositionHash() string {
+	hash := sha256.Sum256([]byte(PieceStrings[game.Turn] + ROW_SEP + game.String()))
+	return hex.EncodeToString(hash[:8])
+}
+
 func ParsePiece(s string) (Piece, bool) {
 	piece, ok := StringPieces[s]
 	return piece, ok
@@ -333,7 +484,7 @@ func Parse(s string) (*Game, error) {
 		return nil, errors.New(fmt.Sprintf("invalid board string: %v", s))
 	}
 	pieces := make(map[Pos]Piece)
-	result := &Game{pieces, BLACK_PLAYER}
+	result := &Game{pieces, BLACK_PLAYER, NO_POS}
 	for y, row := range strings.Split(s, ROW_SEP) {
 		for x, c := range strings.Split(row, "") {
 			if x >= BOARD_DIM || y >= BOARD_DIM {
@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLegalMovesNewGame(t *testing.T) {
+	game := New()
+	moves := game.LegalMoves()
+	require.EqualValues(t, []Move{
+		{Path: []Pos{{1, 2}, {0, 3}}, Captured: []Pos{}},
+		{Path: []Pos{{1, 2}, {2, 3}}, Captured: []Pos{}},
+		{Path: []Pos{{3, 2}, {2, 3}}, Captured: []Pos{}},
+		{Path: []Pos{{3, 2}, {4, 3}}, Captured: []Pos{}},
+		{Path: []Pos{{5, 2}, {4, 3}}, Captured: []Pos{}},
+		{Path: []Pos{{5, 2}, {6, 3}}, Captured: []Pos{}},
+		{Path: []Pos{{7, 2}, {6, 3}}, Captured: []Pos{}},
+	}, moves)
+}
+
+func TestLegalMovesFromWrongTurn(t *testing.T) {
+	game := New()
+	require.Empty(t, game.LegalMovesFrom(Pos{0, 5}))
+	require.Empty(t, game.LegalMovesFrom(Pos{0, 3}))
+}
+
+func TestLegalMovesMandatoryDoubleJump(t *testing.T) {
+	game, err := Parse("*******b|********|*b******|**r*****|********|****r***|********|********")
+	require.Nil(t, err)
+	expected := []Move{
+		{Path: []Pos{{1, 2}, {3, 4}, {5, 6}}, Captured: []Pos{{2, 3}, {4, 5}}},
+	}
+	require.EqualValues(t, expected, game.LegalMoves())
+	require.EqualValues(t, expected, game.LegalMovesFrom(Pos{1, 2}))
+	require.Empty(t, game.LegalMovesFrom(Pos{7, 0}))
+
+	captured, err := game.Move(Pos{1, 2}, Pos{3, 4})
+	require.Nil(t, err)
+	require.Equal(t, Pos{2, 3}, captured)
+	require.Equal(t, BLACK_PLAYER, game.Turn)
+	require.EqualValues(t, []Move{
+		{Path: []Pos{{3, 4}, {5, 6}}, Captured: []Pos{{4, 5}}},
+	}, game.LegalMoves())
+}
+
+func TestLegalMovesCrowningEndsJump(t *testing.T) {
+	game, err := Parse("********|********|********|********|********|**b*****|***r*r**|********")
+	require.Nil(t, err)
+	require.EqualValues(t, []Move{
+		{Path: []Pos{{2, 5}, {4, 7}}, Captured: []Pos{{3, 6}}},
+	}, game.LegalMoves())
+}
+
+func TestLegalMovesDoesNotModifyGame(t *testing.T) {
+	game, err := Parse("*******b|********|*b******|**r*****|********|****r***|********|********")
+	require.Nil(t, err)
+	before := game.String()
+	game.LegalMoves()
+	require.Equal(t, before, game.String())
+	require.Equal(t, BLACK_PLAYER, game.Turn)
+}
+
+func TestMoveMustContinueJumpWithSamePiece(t *testing.T) {
+	game, err := Parse("********|******b*|*b***r**|**r*****|********|****r***|********|********")
+	require.Nil(t, err)
+	_, err = game.Move(Pos{1, 2}, Pos{3, 4})
+	require.Nil(t, err)
+	require.Equal(t, Pos{3, 4}, game.MustJumpFrom)
+	require.Empty(t, game.LegalMovesFrom(Pos{6, 1}))
+	require.EqualValues(t, []Move{
+		{Path: []Pos{{3, 4}, {5, 6}}, Captured: []Pos{{4, 5}}},
+	}, game.LegalMoves())
+
+	_, err = game.Move(Pos{6, 1}, Pos{4, 3})
+	require.EqualError(t, err, "Must continue jumping with piece at: {3 4}")
+
+	_, err = game.Move(Pos{3, 4}, Pos{5, 6})
+	require.Nil(t, err)
+	require.Equal(t, NO_POS, game.MustJumpFrom)
+	require.Equal(t, RED_PLAYER, game.Turn)
+}
+
+func TestWinnerWhenOpponentBlocked(t *testing.T) {
+	game, err := Parse("********|********|*b******|********|*b******|r*******|********|********")
+	require.Nil(t, err)
+	require.Equal(t, NO_PLAYER, game.Winner())
+	_, err = game.Move(Pos{1, 2}, Pos{2, 3})
+	require.Nil(t, err)
+	require.Equal(t, RED_PLAYER, game.Turn)
+	require.Empty(t, game.LegalMoves())
+	require.Equal(t, BLACK_PLAYER, game.Winner())
+}
+
+func TestWinnerNewGame(t *testing.T) {
+	require.Equal(t, NO_PLAYER, New().Winner())
+}
+
+func TestPositionHashDependsOnTurn(t *testing.T) {
+	game := New()
+	hash := game.PositionHash()
+	require.Len(t, hash, 16)
+	require.Equal(t, hash, New().PositionHash())
+	game.Turn = RED_PLAYER
+	require.NotEqual(t, hash, game.PositionHash())
+}
+
+func TestHasPieces(t *testing.T) {
+	game, err := Parse("********|********|*b******|********|********|********|********|********")
+	require.Nil(t, err)
+	require.True(t, game.HasPieces(BLACK_PLAYER))
+	require.False(t, game.HasPieces(RED_PLAYER))
+}
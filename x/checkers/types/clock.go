@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HasClock tells whether the game is played with time banks.
+func (storedGame StoredGame) HasClock() bool {
+	return storedGame.TimeBank > 0
+}
+
+func (storedGame StoredGame) GetTurnStartedAtAsTime() (turnStartedAt time.Time, err error) {
+	turnStartedAt, errTurnStartedAt := time.Parse(DeadlineLayout, storedGame.TurnStartedAt)
+	return turnStartedAt, sdkerrors.Wrapf(errTurnStartedAt, ErrInvalidTurnStartedAt.Error(), storedGame.TurnStartedAt)
+}
+
+// GetClock returns the clock of the player of the given color, which is expected to be black or red.
+func (storedGame *StoredGame) GetClock(color string) *time.Duration {
+	if color == rules.PieceStrings[rules.BLACK_PLAYER] {
+		return &storedGame.BlackClock
+	}
+	return &storedGame.RedClock
+}
+
+func (storedGame StoredGame) getTurnElapsed(now time.Time) (elapsed time.Duration, err error) {
+	turnStartedAt, err := storedGame.GetTurnStartedAtAsTime()
+	if err != nil {
+		return 0, err
+	}
+	return now.Sub(turnStartedAt), nil
+}
+
+// GetTimeLeft returns the time left to the player of the given color at the given time. Only the clock of the
+// player to move is running.
+func (storedGame StoredGame) GetTimeLeft(color string, now time.Time) (timeLeft time.Duration, err error) {
+	timeLeft = *storedGame.GetClock(color)
+	if !storedGame.HasClock() || color != storedGame.Turn {
+		return timeLeft, nil
+	}
+	elapsed, err := storedGame.getTurnElapsed(now)
+	if err != nil {
+		return 0, err
+	}
+	if timeLeft <= elapsed {
+		return 0, nil
+	}
+	return timeLeft - elapsed, nil
+}
+
+// ChargeClock deducts the time spent since the turn, or the previous hop of a multi-jump, started from the clock
+// of the player to move. It fails when the time bank ran out before now.
+func (storedGame *StoredGame) ChargeClock(now time.Time) error {
+	if !storedGame.HasClock() {
+		return nil
+	}
+	elapsed, err := storedGame.getTurnElapsed(now)
+	if err != nil {
+		return err
+	}
+	clock := storedGame.GetClock(storedGame.Turn)
+	if *clock < elapsed {
+		return sdkerrors.Wrapf(ErrTimeBankExhausted, "%s", storedGame.Turn)
+	}
+	*clock -= elapsed
+	return nil
+}
+
+// CreditIncrement credits the increment to the clock of the player to move. Call it once the turn is complete.
+func (storedGame *StoredGame) CreditIncrement() {
+	if !storedGame.HasClock() {
+		return
+	}
+	*storedGame.GetClock(storedGame.Turn) += storedGame.Increment
+}
+
+// IsFlagged tells whether the player to move has run out of time bank at the given time.
+func (storedGame StoredGame) IsFlagged(now time.Time) (flagged bool, err error) {
+	if !storedGame.HasClock() {
+		return false, nil
+	}
+	timeLeft, err := storedGame.GetTimeLeft(storedGame.Turn, now)
+	return err == nil && timeLeft == 0, err
+}
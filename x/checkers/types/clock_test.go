@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+var clockStart = time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+func GetStoredGameWithClock() types.StoredGame {
+	storedGame := GetStoredGame1()
+	storedGame.TimeBank = 10 * time.Minute
+	storedGame.Increment = time.Minute
+	storedGame.BlackClock = 10 * time.Minute
+	storedGame.RedClock = 10 * time.Minute
+	storedGame.TurnStartedAt = types.FormatDeadline(clockStart)
+	return storedGame
+}
+
+func TestGetTimeLeftOnlyRunsForPlayerToMove(t *testing.T) {
+	storedGame := GetStoredGameWithClock()
+	now := clockStart.Add(3 * time.Minute)
+	black, err := storedGame.GetTimeLeft("b", now)
+	require.Nil(t, err)
+	require.Equal(t, 7*time.Minute, black)
+	red, err := storedGame.GetTimeLeft("r", now)
+	require.Nil(t, err)
+	require.Equal(t, 10*time.Minute, red)
+	black, err = storedGame.GetTimeLeft("b", clockStart.Add(time.Hour))
+	require.Nil(t, err)
+	require.Equal(t, time.Duration(0), black)
+}
+
+func TestGetTimeLeftWrongTurnStartedAt(t *testing.T) {
+	storedGame := GetStoredGameWithClock()
+	storedGame.TurnStartedAt = "yesterday"
+	_, err := storedGame.GetTimeLeft("b", clockStart)
+	require.EqualError(t, err, "turnStartedAt cannot be parsed: yesterday: parsing time \"yesterday\" as "+
+		"\"2006-01-02 15:04:05.999999999 +0000 UTC\": cannot parse \"yesterday\" as \"2006\"")
+}
+
+func TestChargeClockDeductsElapsed(t *testing.T) {
+	storedGame := GetStoredGameWithClock()
+	require.Nil(t, storedGame.ChargeClock(clockStart.Add(3*time.Minute)))
+	require.Equal(t, 7*time.Minute, storedGame.BlackClock)
+	require.Equal(t, 10*time.Minute, storedGame.RedClock)
+}
+
+func TestCreditIncrement(t *testing.T) {
+	storedGame := GetStoredGameWithClock()
+	storedGame.CreditIncrement()
+	require.Equal(t, 11*time.Minute, storedGame.BlackClock)
+	require.Equal(t, 10*time.Minute, storedGame.RedClock)
+}
+
+func TestCreditIncrementWithoutClock(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.CreditIncrement()
+	require.Equal(t, time.Duration(0), storedGame.BlackClock)
+}
+
+func TestChargeClockExhausted(t *testing.T) {
+	storedGame := GetStoredGameWithClock()
+	err := storedGame.ChargeClock(clockStart.Add(11 * time.Minute))
+	require.ErrorIs(t, err, types.ErrTimeBankExhausted)
+	require.Equal(t, 10*time.Minute, storedGame.BlackClock)
+}
+
+func TestChargeClockWithoutClock(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.Nil(t, storedGame.ChargeClock(clockStart))
+	require.Equal(t, time.Duration(0), storedGame.BlackClock)
+}
+
+func TestIsFlagged(t *testing.T) {
+	storedGame := GetStoredGameWithClock()
+	flagged, err := storedGame.IsFlagged(clockStart.Add(10 * time.Minute))
+	require.Nil(t, err)
+	require.True(t, flagged)
+	flagged, err = storedGame.IsFlagged(clockStart.Add(9 * time.Minute))
+	require.Nil(t, err)
+	require.False(t, flagged)
+	flagged, err = GetStoredGame1().IsFlagged(clockStart.Add(time.Hour))
+	require.Nil(t, err)
+	require.False(t, flagged)
+}
@@ -11,6 +11,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGame{}, "checkers/CreateGame", nil)
 	cdc.RegisterConcrete(&MsgPlayMove{}, "checkers/PlayMove", nil)
 	cdc.RegisterConcrete(&MsgRejectGame{}, "checkers/RejectGame", nil)
+	cdc.RegisterConcrete(&MsgPlayMoveSequence{}, "checkers/PlayMoveSequence", nil)
+	cdc.RegisterConcrete(&MsgOfferDraw{}, "checkers/OfferDraw", nil)
+	cdc.RegisterConcrete(&MsgAcceptDraw{}, "checkers/AcceptDraw", nil)
+	cdc.RegisterConcrete(&MsgDeclineDraw{}, "checkers/DeclineDraw", nil)
+	cdc.RegisterConcrete(&MsgResign{}, "checkers/Resign", nil)
+	cdc.RegisterConcrete(&MsgClaimTimeout{}, "checkers/ClaimTimeout", nil)
+	cdc.RegisterConcrete(&MsgJoinGame{}, "checkers/JoinGame", nil)
+	cdc.RegisterConcrete(&MsgAcceptGame{}, "checkers/AcceptGame", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -24,6 +32,30 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRejectGame{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlayMoveSequence{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOfferDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeclineDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResign{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimTimeout{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgJoinGame{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptGame{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,8 +28,35 @@ var (
 	ErrCannotPayWinnings = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
 	ErrNotInRefundState  = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move "+
 		"count: %d")
-	ErrWinnerIsNotParseable   = sdkerrors.Register(ModuleName, 1118, "winner is not parseable: %s")
-	ErrThereIsNoWinner        = sdkerrors.Register(ModuleName, 1119, "there is no winner")
-	ErrInvalidDateAdded       = sdkerrors.Register(ModuleName, 1120, "dateAdded cannot be parsed: %s")
-	ErrCannotAddToLeaderboard = sdkerrors.Register(ModuleName, 1121, "cannot add to leaderboard: %s")
+	ErrWinnerIsNotParseable    = sdkerrors.Register(ModuleName, 1118, "winner is not parseable: %s")
+	ErrThereIsNoWinner         = sdkerrors.Register(ModuleName, 1119, "there is no winner")
+	ErrInvalidDateAdded        = sdkerrors.Register(ModuleName, 1120, "dateAdded cannot be parsed: %s")
+	ErrCannotAddToLeaderboard  = sdkerrors.Register(ModuleName, 1121, "cannot add to leaderboard: %s")
+	ErrMoveSequenceTooShort    = sdkerrors.Register(ModuleName, 1122, "move sequence needs at least 2 positions")
+	ErrGameNotDrawn            = sdkerrors.Register(ModuleName, 1123, "game is not drawn, winner: %s")
+	ErrDrawAlreadyOffered      = sdkerrors.Register(ModuleName, 1124, "a draw is already offered")
+	ErrNoDrawOffer             = sdkerrors.Register(ModuleName, 1125, "there is no pending draw offer")
+	ErrOwnDrawOffer            = sdkerrors.Register(ModuleName, 1126, "cannot answer own draw offer")
+	ErrInvalidStatusTransition = sdkerrors.Register(ModuleName, 1127, "invalid game status transition")
+	ErrInvalidTurnDuration     = sdkerrors.Register(ModuleName, 1128, "turn duration is out of bounds")
+	ErrInvalidTimeControl      = sdkerrors.Register(ModuleName, 1129, "time bank and increment are invalid")
+	ErrInvalidTurnStartedAt    = sdkerrors.Register(ModuleName, 1130, "turnStartedAt cannot be parsed: %s")
+	ErrTimeBankExhausted       = sdkerrors.Register(ModuleName, 1131, "time bank is exhausted")
+	ErrInvalidTurnBlocks       = sdkerrors.Register(ModuleName, 1132, "turn blocks are invalid")
+	ErrGameNotExpired          = sdkerrors.Register(ModuleName, 1133, "game has not expired")
+	ErrCannotClaimOwnTimeout   = sdkerrors.Register(ModuleName, 1134, "cannot claim a timeout on own turn")
+	ErrSeatOpen                = sdkerrors.Register(ModuleName, 1135, "game is waiting for a player to join")
+	ErrNoSeatOpen              = sdkerrors.Register(ModuleName, 1136, "game has no seat open")
+	ErrAlreadyPlayer           = sdkerrors.Register(ModuleName, 1137, "already a player in this game")
+	ErrBothSeatsOpen           = sdkerrors.Register(ModuleName, 1138, "at most one seat can be left open")
+	ErrGamePending             = sdkerrors.Register(ModuleName, 1139, "game is waiting for the invitation to be accepted")
+	ErrNotInvited              = sdkerrors.Register(ModuleName, 1140, "no pending invitation")
+	ErrCannotCollectFee        = sdkerrors.Register(ModuleName, 1141, "cannot collect fee: %s")
+	ErrDenomNotAllowed         = sdkerrors.Register(ModuleName, 1142, "denom is not accepted for wagers")
+	ErrWagerOutOfBounds        = sdkerrors.Register(ModuleName, 1143, "wager is out of bounds")
+	ErrInvalidSponsor          = sdkerrors.Register(ModuleName, 1144, "sponsor address is invalid: %s")
+	ErrSponsorCannotPay        = sdkerrors.Register(ModuleName, 1145, "sponsor cannot pay the prize")
+	ErrUnknownRakeRecipient    = sdkerrors.Register(ModuleName, 1146, "rake recipient is not a module account: %s")
+	ErrGameExpired             = sdkerrors.Register(ModuleName, 1147, "game has expired, the move is too late")
+	ErrInvalidGameStatus       = sdkerrors.Register(ModuleName, 1148, "game status is invalid")
 )
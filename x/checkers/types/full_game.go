@@ -19,6 +19,11 @@ func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
 	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
 }
 
+func (storedGame StoredGame) GetSponsorAddress() (sponsor sdk.AccAddress, err error) {
+	sponsor, errSponsor := sdk.AccAddressFromBech32(storedGame.Sponsor)
+	return sponsor, sdkerrors.Wrapf(errSponsor, ErrInvalidSponsor.Error(), storedGame.Sponsor)
+}
+
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 	board, errBoard := rules.Parse(storedGame.Board)
 	if errBoard != nil {
@@ -30,20 +35,138 @@ func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 			errors.New(fmt.Sprintf("Turn: %s", storedGame.Turn)), ErrGameNotParseable.Error(),
 		)
 	}
+	board.MustJumpFrom = storedGame.GetMustJumpFromPos()
+	if board.MustJumpFrom != rules.NO_POS &&
+		(!board.PieceAt(board.MustJumpFrom) || !board.TurnIs(board.Pieces[board.MustJumpFrom].Player)) {
+		return nil, sdkerrors.Wrapf(
+			errors.New(fmt.Sprintf("MustJumpFrom: %v", board.MustJumpFrom)), ErrGameNotParseable.Error(),
+		)
+	}
 	return board, nil
 }
 
+func (storedGame StoredGame) GetMustJumpFromPos() rules.Pos {
+	if storedGame.MustJumpFrom == nil {
+		return rules.NO_POS
+	}
+	return storedGame.MustJumpFrom.ToPos()
+}
+
+func (storedGame *StoredGame) SetMustJumpFromPos(pos rules.Pos) {
+	if pos == rules.NO_POS {
+		storedGame.MustJumpFrom = nil
+	} else {
+		position := NewPosition(pos)
+		storedGame.MustJumpFrom = &position
+	}
+}
+
+// RecordPosition records the move from the previous position to the reached one. A capture or a man move makes all
+// earlier positions unreachable, so it clears both the history and the no-progress count, and nothing needs to be
+// kept until the next move without progress.
+func (storedGame *StoredGame) RecordPosition(previousHash string, reachedHash string, progress bool) {
+	if progress {
+		storedGame.PositionHistory = nil
+		storedGame.NoProgressCount = 0
+		return
+	}
+	if len(storedGame.PositionHistory) == 0 {
+		storedGame.PositionHistory = append(storedGame.PositionHistory, previousHash)
+	}
+	storedGame.PositionHistory = append(storedGame.PositionHistory, reachedHash)
+	storedGame.NoProgressCount++
+}
+
+// IsAutomaticDraw tells whether the last recorded position occurred for the third time, or whether too many
+// moves were played without progress. A noProgressMoveLimit of 0 disables the latter.
+func (storedGame StoredGame) IsAutomaticDraw(noProgressMoveLimit uint64) bool {
+	if noProgressMoveLimit > 0 && storedGame.NoProgressCount >= noProgressMoveLimit {
+		return true
+	}
+	return storedGame.IsThreefoldRepetition()
+}
+
+// IsThreefoldRepetition tells whether the last recorded position occurred for the third time.
+func (storedGame StoredGame) IsThreefoldRepetition() bool {
+	if len(storedGame.PositionHistory) == 0 {
+		return false
+	}
+	last := storedGame.PositionHistory[len(storedGame.PositionHistory)-1]
+	occurrences := 0
+	for _, positionHash := range storedGame.PositionHistory {
+		if positionHash == last {
+			occurrences++
+		}
+	}
+	return occurrences >= RepetitionDrawCount
+}
+
 func (storedGame StoredGame) GetDeadlineAsTime() (deadline time.Time, err error) {
 	deadline, errDeadline := time.Parse(DeadlineLayout, storedGame.Deadline)
 	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), storedGame.Deadline)
 }
 
+// HasHeightDeadline tells whether the current deadline of the game is counted in blocks. The invitation deadline
+// of a pending game is always in wall-clock time.
+func (storedGame StoredGame) HasHeightDeadline() bool {
+	return storedGame.TurnBlocks > 0 && storedGame.Status != GameStatus_GAME_STATUS_PENDING
+}
+
+// IsExpired tells whether the player to move missed the deadline, as of the current block.
+func (storedGame StoredGame) IsExpired(ctx sdk.Context) (expired bool, err error) {
+	if storedGame.HasHeightDeadline() {
+		return storedGame.DeadlineHeight < ctx.BlockHeight(), nil
+	}
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		return false, err
+	}
+	return deadline.Before(ctx.BlockTime()), nil
+}
+
+// StartTurn starts the turn of the player to move in the current block, and sets the deadline accordingly.
+func (storedGame *StoredGame) StartTurn(ctx sdk.Context) {
+	if storedGame.HasHeightDeadline() {
+		storedGame.DeadlineHeight = ctx.BlockHeight() + int64(storedGame.TurnBlocks)
+		storedGame.Deadline = ""
+		return
+	}
+	if storedGame.HasClock() {
+		storedGame.TurnStartedAt = FormatDeadline(ctx.BlockTime())
+	}
+	storedGame.Deadline = FormatDeadline(storedGame.GetNextDeadline(ctx))
+}
+
+// StartInvitation sets the deadline by which the invitees have to accept the game.
+func (storedGame *StoredGame) StartInvitation(ctx sdk.Context, invitationDuration time.Duration) {
+	storedGame.Deadline = FormatDeadline(ctx.BlockTime().Add(invitationDuration))
+}
+
+// RemoveInvitee records the acceptance of the address, and tells whether it was invited.
+func (storedGame *StoredGame) RemoveInvitee(address string) bool {
+	for i, invitee := range storedGame.Invitees {
+		if invitee == address {
+			storedGame.Invitees = append(storedGame.Invitees[:i], storedGame.Invitees[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 func FormatDeadline(deadline time.Time) string {
 	return deadline.UTC().Format(DeadlineLayout)
 }
 
-func GetNextDeadline(ctx sdk.Context) time.Time {
-	return ctx.BlockTime().Add(MaxTurnDuration)
+// GetNextDeadline returns the deadline of the player to move, whose turn starts now. With a clock, it comes
+// earlier when the time bank runs out before the turn duration is up.
+func (storedGame StoredGame) GetNextDeadline(ctx sdk.Context) time.Time {
+	turnDuration := storedGame.TurnDuration
+	if storedGame.HasClock() {
+		if clock := *storedGame.GetClock(storedGame.Turn); clock < turnDuration {
+			turnDuration = clock
+		}
+	}
+	return ctx.BlockTime().Add(turnDuration)
 }
 
 func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddress, found bool, err error) {
@@ -62,27 +185,139 @@ func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddr
 	return address, found, nil
 }
 
+// GetPlayerColor returns the color played by the address. When the address plays both colors, it is the color
+// whose turn it is.
+func (storedGame StoredGame) GetPlayerColor(address string) (color string, found bool) {
+	isBlack := storedGame.Black == address
+	isRed := storedGame.Red == address
+	if isBlack && isRed {
+		return storedGame.Turn, true
+	} else if isBlack {
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	} else if isRed {
+		return rules.PieceStrings[rules.RED_PLAYER], true
+	}
+	return "", false
+}
+
+// GetOpenSeat returns the color of the seat left open for MsgJoinGame, if any.
+func (storedGame StoredGame) GetOpenSeat() (color string, found bool) {
+	if storedGame.Black == "" {
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	} else if storedGame.Red == "" {
+		return rules.PieceStrings[rules.RED_PLAYER], true
+	}
+	return "", false
+}
+
+// HasPaid tells whether the wager of the color is in escrow.
+func (storedGame StoredGame) HasPaid(color string) bool {
+	for _, paidColor := range storedGame.PaidColors {
+		if paidColor == color {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPaid records that the wager of the color is in escrow.
+func (storedGame *StoredGame) SetPaid(color string) {
+	if !storedGame.HasPaid(color) {
+		storedGame.PaidColors = append(storedGame.PaidColors, color)
+	}
+}
+
+// HasBothPaid tells whether the wagers of both players are in escrow.
+func (storedGame StoredGame) HasBothPaid() bool {
+	return storedGame.HasPaid(rules.PieceStrings[rules.BLACK_PLAYER]) &&
+		storedGame.HasPaid(rules.PieceStrings[rules.RED_PLAYER])
+}
+
+// IsReadyToStart tells whether both seats are taken and all invitations accepted.
+func (storedGame StoredGame) IsReadyToStart() bool {
+	_, openSeat := storedGame.GetOpenSeat()
+	return !openSeat && storedGame.Status != GameStatus_GAME_STATUS_PENDING
+}
+
 func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found bool, err error) {
 	return storedGame.GetPlayerAddress(storedGame.Winner)
 }
 
-func (storedGame StoredGame) GetWagerCoin() (wager sdk.Coin) {
-	return sdk.NewCoin(storedGame.Denom, sdk.NewInt(int64(storedGame.Wager)))
+// GetWagerOf returns the coins staked by the player of color.
+func (storedGame StoredGame) GetWagerOf(color string) sdk.Coins {
+	if color == rules.PieceStrings[rules.BLACK_PLAYER] {
+		return storedGame.BlackWager
+	}
+	return storedGame.RedWager
+}
+
+// ValidateStatus checks that the status is set to a known value. The zero value is never valid, so that a game
+// stored without a status is not taken as open.
+func (storedGame StoredGame) ValidateStatus() error {
+	if _, found := GameStatus_name[int32(storedGame.Status)]; !found ||
+		storedGame.Status == GameStatus_GAME_STATUS_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalidGameStatus, "%s", storedGame.Status)
+	}
+	return nil
+}
+
+// GetPot returns the sum of the wagers and of the prize in escrow.
+func (storedGame StoredGame) GetPot() sdk.Coins {
+	pot := sdk.NewCoins()
+	for _, color := range storedGame.PaidColors {
+		pot = pot.Add(storedGame.GetWagerOf(color)...)
+	}
+	if storedGame.PrizeInEscrow {
+		pot = pot.Add(storedGame.Prize...)
+	}
+	return pot
 }
 
 func (storedGame StoredGame) Validate() (err error) {
-	_, err = storedGame.GetBlackAddress()
-	if err != nil {
-		return
+	if err = storedGame.ValidateStatus(); err != nil {
+		return err
 	}
-	_, err = storedGame.GetRedAddress()
-	if err != nil {
-		return
+	if storedGame.Black == "" && storedGame.Red == "" {
+		return ErrBothSeatsOpen
+	}
+	if storedGame.Black != "" {
+		_, err = storedGame.GetBlackAddress()
+		if err != nil {
+			return
+		}
+	}
+	if storedGame.Red != "" {
+		_, err = storedGame.GetRedAddress()
+		if err != nil {
+			return
+		}
+	}
+	if storedGame.Sponsor != "" {
+		_, err = storedGame.GetSponsorAddress()
+		if err != nil {
+			return
+		}
+	}
+	if err = storedGame.Prize.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid prize (%s)", err)
+	}
+	if err = storedGame.BlackWager.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid black wager (%s)", err)
+	}
+	if err = storedGame.RedWager.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid red wager (%s)", err)
 	}
 	_, err = storedGame.ParseGame()
 	if err != nil {
 		return
 	}
+	if storedGame.HasHeightDeadline() {
+		return
+	}
 	_, err = storedGame.GetDeadlineAsTime()
+	if err != nil || !storedGame.HasClock() || storedGame.Status == GameStatus_GAME_STATUS_PENDING {
+		return
+	}
+	_, err = storedGame.GetTurnStartedAtAsTime()
 	return
 }
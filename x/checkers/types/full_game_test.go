@@ -5,6 +5,7 @@ import (
 	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 	"strings"
 	"testing"
@@ -25,6 +26,7 @@ func GetStoredGame1() types.StoredGame {
 		Turn:     "b",
 		Board:    rules.New().String(),
 		Deadline: types.DeadlineLayout,
+		Status:   types.GameStatus_GAME_STATUS_OPEN,
 	}
 }
 
@@ -213,3 +215,219 @@ func TestValidateOk(t *testing.T) {
 	storedGame := GetStoredGame1()
 	require.NoError(t, storedGame.Validate())
 }
+
+func TestValidateOpenSeatOk(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Red = ""
+	require.NoError(t, storedGame.Validate())
+}
+
+func TestValidateBothSeatsOpen(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Black = ""
+	storedGame.Red = ""
+	require.ErrorIs(t, storedGame.Validate(), types.ErrBothSeatsOpen)
+}
+
+func TestValidateStatusUnspecified(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Status = types.GameStatus_GAME_STATUS_UNSPECIFIED
+	require.EqualError(t, storedGame.Validate(), "GAME_STATUS_UNSPECIFIED: game status is invalid")
+	storedGame.Status = 99
+	require.ErrorIs(t, storedGame.Validate(), types.ErrInvalidGameStatus)
+}
+
+func TestGetOpenSeat(t *testing.T) {
+	storedGame := GetStoredGame1()
+	_, found := storedGame.GetOpenSeat()
+	require.False(t, found)
+	storedGame.Black = ""
+	color, found := storedGame.GetOpenSeat()
+	require.True(t, found)
+	require.Equal(t, "b", color)
+	storedGame.Black = alice
+	storedGame.Red = ""
+	color, found = storedGame.GetOpenSeat()
+	require.True(t, found)
+	require.Equal(t, "r", color)
+}
+
+func TestHasPaid(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.False(t, storedGame.HasPaid("b"))
+	require.False(t, storedGame.HasPaid("r"))
+	storedGame.SetPaid("r")
+	require.False(t, storedGame.HasPaid("b"))
+	require.True(t, storedGame.HasPaid("r"))
+	storedGame.SetPaid("b")
+	storedGame.SetPaid("r")
+	require.Equal(t, []string{"r", "b"}, storedGame.PaidColors)
+	require.True(t, storedGame.HasPaid("b"))
+	require.False(t, storedGame.HasPaid("*"))
+}
+
+func TestIsReadyToStart(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.True(t, storedGame.IsReadyToStart())
+	storedGame.Status = types.GameStatus_GAME_STATUS_PENDING
+	require.False(t, storedGame.IsReadyToStart())
+	storedGame.Status = types.GameStatus_GAME_STATUS_OPEN
+	storedGame.Red = ""
+	require.False(t, storedGame.IsReadyToStart())
+}
+
+func TestGetPlayerColor(t *testing.T) {
+	storedGame := GetStoredGame1()
+	color, found := storedGame.GetPlayerColor(alice)
+	require.True(t, found)
+	require.Equal(t, "b", color)
+	color, found = storedGame.GetPlayerColor(bob)
+	require.True(t, found)
+	require.Equal(t, "r", color)
+	_, found = storedGame.GetPlayerColor(badAddress)
+	require.False(t, found)
+}
+
+func TestGetPlayerColorSameBlackRed(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Red = alice
+	storedGame.Turn = "r"
+	color, found := storedGame.GetPlayerColor(alice)
+	require.True(t, found)
+	require.Equal(t, "r", color)
+}
+
+func TestRecordPositionProgressClears(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.RecordPosition("a", "b", false)
+	require.Equal(t, []string{"a", "b"}, storedGame.PositionHistory)
+	require.EqualValues(t, 1, storedGame.NoProgressCount)
+	storedGame.RecordPosition("b", "c", false)
+	require.Equal(t, []string{"a", "b", "c"}, storedGame.PositionHistory)
+	require.EqualValues(t, 2, storedGame.NoProgressCount)
+	storedGame.RecordPosition("c", "d", true)
+	require.Nil(t, storedGame.PositionHistory)
+	require.EqualValues(t, 0, storedGame.NoProgressCount)
+}
+
+func TestIsAutomaticDrawThreefold(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.PositionHistory = []string{"a", "b", "a", "b"}
+	require.False(t, storedGame.IsAutomaticDraw(0))
+	storedGame.PositionHistory = append(storedGame.PositionHistory, "a")
+	require.True(t, storedGame.IsAutomaticDraw(0))
+}
+
+func TestIsAutomaticDrawNoProgress(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.NoProgressCount = 9
+	require.False(t, storedGame.IsAutomaticDraw(10))
+	require.False(t, storedGame.IsAutomaticDraw(0))
+	storedGame.NoProgressCount = 10
+	require.True(t, storedGame.IsAutomaticDraw(10))
+}
+
+func TestGetPlayingStatus(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.MoveCount = 1
+	require.Equal(t, types.GameStatus_GAME_STATUS_OPEN, storedGame.GetPlayingStatus())
+	storedGame.MoveCount = 2
+	require.Equal(t, types.GameStatus_GAME_STATUS_ACTIVE, storedGame.GetPlayingStatus())
+}
+
+func TestTransitionToAllowed(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.Nil(t, storedGame.TransitionTo(types.GameStatus_GAME_STATUS_ACTIVE))
+	require.Nil(t, storedGame.TransitionTo(types.GameStatus_GAME_STATUS_FORFEITED))
+	require.Equal(t, types.GameStatus_GAME_STATUS_FORFEITED, storedGame.Status)
+	require.False(t, storedGame.Status.IsOngoing())
+}
+
+func TestTransitionToActiveCannotBeRejected(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Status = types.GameStatus_GAME_STATUS_ACTIVE
+	err := storedGame.TransitionTo(types.GameStatus_GAME_STATUS_REJECTED)
+	require.EqualError(t, err, "GAME_STATUS_ACTIVE to GAME_STATUS_REJECTED: invalid game status transition")
+	require.Equal(t, types.GameStatus_GAME_STATUS_ACTIVE, storedGame.Status)
+}
+
+func TestTransitionToFromTerminal(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Status = types.GameStatus_GAME_STATUS_EXPIRED
+	require.NotNil(t, storedGame.TransitionTo(types.GameStatus_GAME_STATUS_ACTIVE))
+	require.Panics(t, func() { storedGame.MustTransitionTo(types.GameStatus_GAME_STATUS_OPEN) })
+}
+
+func TestTransitionToFromPending(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Status = types.GameStatus_GAME_STATUS_PENDING
+	require.True(t, storedGame.Status.IsOngoing())
+	require.NotNil(t, storedGame.TransitionTo(types.GameStatus_GAME_STATUS_ACTIVE))
+	require.Nil(t, storedGame.TransitionTo(types.GameStatus_GAME_STATUS_OPEN))
+}
+
+func TestRemoveInvitee(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Invitees = []string{alice, bob}
+	require.False(t, storedGame.RemoveInvitee(badAddress))
+	require.True(t, storedGame.RemoveInvitee(alice))
+	require.Equal(t, []string{bob}, storedGame.Invitees)
+	require.False(t, storedGame.RemoveInvitee(alice))
+	require.True(t, storedGame.RemoveInvitee(bob))
+	require.Empty(t, storedGame.Invitees)
+}
+
+func TestIsExpiredByTime(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Deadline = types.FormatDeadline(clockStart)
+	ctx := sdk.Context{}.WithBlockTime(clockStart)
+	expired, err := storedGame.IsExpired(ctx)
+	require.Nil(t, err)
+	require.False(t, expired)
+	expired, err = storedGame.IsExpired(ctx.WithBlockTime(clockStart.Add(time.Nanosecond)))
+	require.Nil(t, err)
+	require.True(t, expired)
+}
+
+func TestIsExpiredByHeight(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Deadline = ""
+	storedGame.TurnBlocks = 10
+	storedGame.StartTurn(sdk.Context{}.WithBlockHeight(5))
+	require.EqualValues(t, 15, storedGame.DeadlineHeight)
+	require.Equal(t, "", storedGame.Deadline)
+	expired, err := storedGame.IsExpired(sdk.Context{}.WithBlockHeight(15))
+	require.Nil(t, err)
+	require.False(t, expired)
+	expired, err = storedGame.IsExpired(sdk.Context{}.WithBlockHeight(16))
+	require.Nil(t, err)
+	require.True(t, expired)
+	require.Nil(t, storedGame.Validate())
+}
+
+func TestGetPot(t *testing.T) {
+	storedGame := types.StoredGame{
+		BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3)),
+		RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	}
+	require.True(t, storedGame.GetPot().Empty())
+	storedGame.SetPaid("r")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), storedGame.GetPot())
+	storedGame.SetPaid("b")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40), sdk.NewInt64Coin("token", 3)), storedGame.GetPot())
+}
+
+func TestValidateInvalidWager(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.RedWager = sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("coin", 3)}
+	require.ErrorIs(t, storedGame.Validate(), sdkerrors.ErrInvalidCoins)
+}
+
+func TestHasBothPaid(t *testing.T) {
+	storedGame := types.StoredGame{}
+	require.False(t, storedGame.HasBothPaid())
+	storedGame.SetPaid("b")
+	require.False(t, storedGame.HasBothPaid())
+	storedGame.SetPaid("r")
+	require.True(t, storedGame.HasBothPaid())
+}
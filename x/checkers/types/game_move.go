@@ -0,0 +1,9 @@
+package types
+
+import (
+	"time"
+)
+
+func FormatBlockTime(blockTime time.Time) string {
+	return blockTime.UTC().Format(BlockTimeLayout)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gameStatusTransitions lists, for each status, the statuses a game may go to next. Terminal statuses have none.
+var gameStatusTransitions = map[GameStatus][]GameStatus{
+	GameStatus_GAME_STATUS_PENDING: {
+		GameStatus_GAME_STATUS_PENDING,
+		GameStatus_GAME_STATUS_OPEN,
+		GameStatus_GAME_STATUS_REJECTED,
+		GameStatus_GAME_STATUS_EXPIRED,
+	},
+	GameStatus_GAME_STATUS_OPEN: {
+		GameStatus_GAME_STATUS_OPEN,
+		GameStatus_GAME_STATUS_ACTIVE,
+		GameStatus_GAME_STATUS_FINISHED,
+		GameStatus_GAME_STATUS_DRAWN,
+		GameStatus_GAME_STATUS_REJECTED,
+		GameStatus_GAME_STATUS_EXPIRED,
+	},
+	GameStatus_GAME_STATUS_ACTIVE: {
+		GameStatus_GAME_STATUS_ACTIVE,
+		GameStatus_GAME_STATUS_FINISHED,
+		GameStatus_GAME_STATUS_DRAWN,
+		GameStatus_GAME_STATUS_FORFEITED,
+	},
+}
+
+// IsOngoing tells whether a game with this status can still be played, or will once accepted.
+func (status GameStatus) IsOngoing() bool {
+	return status == GameStatus_GAME_STATUS_PENDING || status == GameStatus_GAME_STATUS_OPEN ||
+		status == GameStatus_GAME_STATUS_ACTIVE
+}
+
+// CanTransitionTo tells whether a game with this status may go to the next one.
+func (status GameStatus) CanTransitionTo(next GameStatus) bool {
+	for _, allowed := range gameStatusTransitions[status] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPlayingStatus returns the status of a game still being played. It stays open until both players have
+// made their first move, as until then it can be rejected or expire without a winner.
+func (storedGame StoredGame) GetPlayingStatus() GameStatus {
+	if storedGame.MoveCount <= 1 {
+		return GameStatus_GAME_STATUS_OPEN
+	}
+	return GameStatus_GAME_STATUS_ACTIVE
+}
+
+// TransitionTo moves the game to the next status, provided the state machine allows it.
+func (storedGame *StoredGame) TransitionTo(next GameStatus) error {
+	if !storedGame.Status.CanTransitionTo(next) {
+		return sdkerrors.Wrapf(ErrInvalidStatusTransition, "%s to %s", storedGame.Status, next)
+	}
+	storedGame.Status = next
+	return nil
+}
+
+// MustTransitionTo is TransitionTo for transitions that the caller has already checked.
+func (storedGame *StoredGame) MustTransitionTo(next GameStatus) {
+	if err := storedGame.TransitionTo(next); err != nil {
+		panic(err.Error())
+	}
+}
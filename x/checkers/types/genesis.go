@@ -11,15 +11,14 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SystemInfo: SystemInfo{
-			NextId:        uint64(DefaultIndex),
-			FifoHeadIndex: NoFifoIndex,
-			FifoTailIndex: NoFifoIndex,
+			NextId: uint64(DefaultIndex),
 		},
 		StoredGameList: []StoredGame{},
 		PlayerInfoList: []PlayerInfo{},
 		Leaderboard: Leaderboard{
 			Winners: []WinningPlayer{},
 		},
+		GameMoveList: []GameMove{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -37,6 +36,18 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for storedGame")
 		}
 		storedGameIndexMap[index] = struct{}{}
+		if err := elem.ValidateStatus(); err != nil {
+			return fmt.Errorf("invalid status for storedGame %s: %w", elem.Index, err)
+		}
+		if err := elem.BlackWager.Validate(); err != nil {
+			return fmt.Errorf("invalid black wager for storedGame %s: %w", elem.Index, err)
+		}
+		if err := elem.RedWager.Validate(); err != nil {
+			return fmt.Errorf("invalid red wager for storedGame %s: %w", elem.Index, err)
+		}
+		if err := elem.Prize.Validate(); err != nil {
+			return fmt.Errorf("invalid prize for storedGame %s: %w", elem.Index, err)
+		}
 	}
 	// Check for duplicated index in playerInfo
 	playerInfoIndexMap := make(map[string]struct{})
@@ -52,6 +63,16 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Leaderboard.Validate(); err != nil {
 		return err
 	}
+	// Check for duplicated index in gameMove
+	gameMoveIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GameMoveList {
+		index := string(GameMoveKey(elem.GameIndex, elem.MoveIndex))
+		if _, ok := gameMoveIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for gameMove")
+		}
+		gameMoveIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -30,6 +30,7 @@ type GenesisState struct {
 	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
 	PlayerInfoList []PlayerInfo `protobuf:"bytes,4,rep,name=playerInfoList,proto3" json:"playerInfoList"`
 	Leaderboard    Leaderboard  `protobuf:"bytes,5,opt,name=leaderboard,proto3" json:"leaderboard"`
+	GameMoveList   []GameMove   `protobuf:"bytes,6,rep,name=gameMoveList,proto3" json:"gameMoveList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +101,13 @@ func (m *GenesisState) GetLeaderboard() Leaderboard {
 	return Leaderboard{}
 }
 
+func (m *GenesisState) GetGameMoveList() []GameMove {
+	if m != nil {
+		return m.GameMoveList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "alice.checkers.checkers.GenesisState")
 }
@@ -107,28 +115,30 @@ func init() {
 func init() { proto.RegisterFile("checkers/genesis.proto", fileDescriptor_6e928243c164a8dc) }
 
 var fileDescriptor_6e928243c164a8dc = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd1, 0xbd, 0x4e, 0xc3, 0x30,
-	0x10, 0x07, 0xf0, 0x84, 0x96, 0x0e, 0x2e, 0x62, 0x88, 0xf8, 0x88, 0x32, 0xb8, 0x15, 0x30, 0x20,
-	0x86, 0x44, 0x82, 0x99, 0xa5, 0x42, 0xaa, 0x2a, 0x75, 0x28, 0x74, 0x63, 0xa9, 0xdc, 0xf4, 0x9a,
-	0x46, 0x34, 0x71, 0x64, 0x1b, 0x89, 0xbc, 0x03, 0x03, 0x8f, 0xd5, 0xb1, 0x23, 0x13, 0x42, 0xc9,
-	0x8b, 0xa0, 0xd8, 0xa9, 0x1b, 0x28, 0x81, 0xed, 0x94, 0xfb, 0xdf, 0x2f, 0x3e, 0x1b, 0x9d, 0xf8,
-	0x0b, 0xf0, 0x9f, 0x80, 0x71, 0x2f, 0x80, 0x18, 0x78, 0xc8, 0xdd, 0x84, 0x51, 0x41, 0xad, 0x53,
-	0xb2, 0x0c, 0x7d, 0x70, 0x37, 0x5d, 0x5d, 0x38, 0x47, 0x01, 0x0d, 0xa8, 0xcc, 0x78, 0x45, 0xa5,
-	0xe2, 0xce, 0xb1, 0x66, 0x12, 0xc2, 0x48, 0x54, 0x2a, 0x8e, 0xa3, 0x3f, 0xf3, 0x94, 0x0b, 0x88,
-	0x26, 0x61, 0x3c, 0xa7, 0xbb, 0x3d, 0x41, 0x19, 0xcc, 0x26, 0x01, 0x89, 0x60, 0xa7, 0x97, 0x2c,
-	0x49, 0x0a, 0xec, 0xf7, 0xb9, 0x25, 0x90, 0x19, 0xb0, 0x29, 0x25, 0x6c, 0xa6, 0x7a, 0x67, 0xaf,
-	0x0d, 0x74, 0xd0, 0x57, 0x7b, 0x8c, 0x05, 0x11, 0x60, 0xdd, 0xa2, 0x96, 0x3a, 0x90, 0x6d, 0x76,
-	0xcd, 0xcb, 0xf6, 0x75, 0xc7, 0xad, 0xd9, 0xcb, 0x1d, 0xc9, 0x58, 0xaf, 0xb9, 0xfa, 0xe8, 0x18,
-	0x0f, 0xe5, 0x90, 0x35, 0x40, 0x48, 0x1d, 0x7c, 0x10, 0xcf, 0xa9, 0xbd, 0x27, 0x89, 0xf3, 0x5a,
-	0x62, 0xac, 0xa3, 0x25, 0x53, 0x19, 0xb6, 0xee, 0xd1, 0xa1, 0xda, 0xb3, 0x4f, 0x22, 0x18, 0x86,
-	0x5c, 0xd8, 0x8d, 0x6e, 0xe3, 0x6f, 0x4e, 0xc7, 0x4b, 0xee, 0x07, 0x50, 0x90, 0xea, 0x7a, 0x8a,
-	0x1f, 0x48, 0xb2, 0xf9, 0x0f, 0x39, 0xd2, 0xf1, 0x0d, 0xf9, 0x1d, 0xb0, 0x86, 0xa8, 0x5d, 0xb9,
-	0x55, 0x7b, 0x5f, 0x6e, 0x7c, 0x51, 0xeb, 0x0d, 0xb7, 0xd9, 0x12, 0xac, 0x8e, 0xf7, 0xee, 0x56,
-	0x19, 0x36, 0xd7, 0x19, 0x36, 0x3f, 0x33, 0x6c, 0xbe, 0xe5, 0xd8, 0x58, 0xe7, 0xd8, 0x78, 0xcf,
-	0xb1, 0xf1, 0x78, 0x15, 0x84, 0x62, 0xf1, 0x3c, 0x75, 0x7d, 0x1a, 0x79, 0x12, 0xf7, 0xf4, 0xab,
-	0xbe, 0x6c, 0x4b, 0x91, 0x26, 0xc0, 0xa7, 0x2d, 0xf9, 0xb6, 0x37, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x92, 0x55, 0xd3, 0x62, 0xab, 0x02, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
+	0x14, 0xc5, 0xdb, 0x3f, 0xfc, 0x59, 0x0c, 0xc4, 0x45, 0xe3, 0x47, 0xd3, 0x45, 0xc1, 0x8f, 0x85,
+	0x71, 0xd1, 0x26, 0xba, 0x76, 0x43, 0x4c, 0x08, 0x11, 0x13, 0x94, 0x9d, 0x1b, 0x32, 0x94, 0x4b,
+	0x69, 0x64, 0x98, 0x66, 0x66, 0x24, 0xf2, 0x16, 0x3e, 0x16, 0x4b, 0x96, 0xba, 0x31, 0x06, 0x5e,
+	0xc4, 0x74, 0x66, 0x18, 0x40, 0xac, 0xee, 0x6e, 0x7a, 0xce, 0xf9, 0x75, 0xce, 0x9d, 0x41, 0x87,
+	0xd1, 0x10, 0xa2, 0x27, 0x60, 0x3c, 0x8c, 0x61, 0x0c, 0x3c, 0xe1, 0x41, 0xca, 0xa8, 0xa0, 0xce,
+	0x11, 0x1e, 0x25, 0x11, 0x04, 0x2b, 0xd5, 0x0c, 0xde, 0x7e, 0x4c, 0x63, 0x2a, 0x3d, 0x61, 0x36,
+	0x29, 0xbb, 0x77, 0x60, 0x30, 0x29, 0x66, 0x98, 0x68, 0x8a, 0xe7, 0x99, 0xcf, 0x7c, 0xca, 0x05,
+	0x90, 0x6e, 0x32, 0x1e, 0xd0, 0x5d, 0x4d, 0x50, 0x06, 0xfd, 0x6e, 0x8c, 0x09, 0xec, 0x68, 0xe9,
+	0x08, 0x4f, 0x81, 0xfd, 0x9c, 0x1b, 0x01, 0xee, 0x03, 0xeb, 0x51, 0xcc, 0xfa, 0x5a, 0x73, 0xd7,
+	0x6d, 0x30, 0x81, 0x2e, 0xa1, 0x13, 0x4d, 0x3c, 0x79, 0x2f, 0xa0, 0x4a, 0x43, 0x35, 0xec, 0x08,
+	0x2c, 0xc0, 0xb9, 0x46, 0x25, 0x75, 0x54, 0xd7, 0xae, 0xd9, 0xe7, 0xe5, 0xcb, 0x6a, 0x90, 0xd3,
+	0x38, 0x68, 0x4b, 0x5b, 0xbd, 0x38, 0xfb, 0xa8, 0x5a, 0x0f, 0x3a, 0xe4, 0x34, 0x11, 0x52, 0x95,
+	0x9a, 0xe3, 0x01, 0x75, 0xff, 0x49, 0xc4, 0x69, 0x2e, 0xa2, 0x63, 0xac, 0x1a, 0xb3, 0x11, 0x76,
+	0xee, 0xd1, 0x9e, 0xda, 0x40, 0x03, 0x13, 0x68, 0x25, 0x5c, 0xb8, 0x85, 0x5a, 0xe1, 0x77, 0x9c,
+	0xb1, 0x6b, 0xdc, 0x37, 0x40, 0x86, 0x54, 0x8b, 0xcb, 0x7e, 0x20, 0x91, 0xc5, 0x3f, 0x90, 0x6d,
+	0x63, 0x5f, 0x21, 0xb7, 0x01, 0x4e, 0x0b, 0x95, 0x37, 0xf6, 0xed, 0xfe, 0x97, 0x8d, 0xcf, 0x72,
+	0x79, 0xad, 0xb5, 0x57, 0x03, 0x37, 0xe3, 0xce, 0x2d, 0xaa, 0x64, 0x37, 0x74, 0x47, 0x27, 0xaa,
+	0x71, 0x49, 0x1e, 0xef, 0x38, 0x17, 0xd7, 0xd0, 0x66, 0xcd, 0xda, 0x0a, 0xd7, 0x6f, 0x66, 0x0b,
+	0xdf, 0x9e, 0x2f, 0x7c, 0xfb, 0x73, 0xe1, 0xdb, 0xaf, 0x4b, 0xdf, 0x9a, 0x2f, 0x7d, 0xeb, 0x6d,
+	0xe9, 0x5b, 0x8f, 0x17, 0x71, 0x22, 0x86, 0xcf, 0xbd, 0x20, 0xa2, 0x24, 0x94, 0xe8, 0xd0, 0x3c,
+	0x90, 0x97, 0xf5, 0x28, 0xa6, 0x29, 0xf0, 0x5e, 0x49, 0x3e, 0x94, 0xab, 0xaf, 0x01, 0x00, 0x67,
+	0x52, 0xf8, 0x27, 0x12, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +161,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GameMoveList) > 0 {
+		for iNdEx := len(m.GameMoveList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GameMoveList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.Leaderboard.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -247,6 +271,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Leaderboard.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.GameMoveList) > 0 {
+		for _, e := range m.GameMoveList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +482,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameMoveList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameMoveList = append(m.GameMoveList, GameMove{})
+			if err := m.GameMoveList[len(m.GameMoveList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
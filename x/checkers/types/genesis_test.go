@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,16 +22,18 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				SystemInfo: types.SystemInfo{
 					NextId: 60,
 				},
 				StoredGameList: []types.StoredGame{
 					{
-						Index: "0",
+						Index:  "0",
+						Status: types.GameStatus_GAME_STATUS_OPEN,
 					},
 					{
-						Index: "1",
+						Index:  "1",
+						Status: types.GameStatus_GAME_STATUS_ACTIVE,
 					},
 				},
 				PlayerInfoList: []types.PlayerInfo{
@@ -55,6 +58,16 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 					},
 				},
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex: "0",
+						MoveIndex: 0,
+					},
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -64,10 +77,12 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				StoredGameList: []types.StoredGame{
 					{
-						Index: "0",
+						Index:  "0",
+						Status: types.GameStatus_GAME_STATUS_OPEN,
 					},
 					{
-						Index: "0",
+						Index:  "0",
+						Status: types.GameStatus_GAME_STATUS_OPEN,
 					},
 				},
 			},
@@ -103,6 +118,46 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated gameMove",
+			genState: &types.GenesisState{
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid storedGame wager",
+			genState: &types.GenesisState{
+				StoredGameList: []types.StoredGame{
+					{
+						Index:    "0",
+						RedWager: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+						Status:   types.GameStatus_GAME_STATUS_OPEN,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified storedGame status",
+			genState: &types.GenesisState{
+				StoredGameList: []types.StoredGame{
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -119,16 +174,16 @@ func TestGenesisState_Validate(t *testing.T) {
 func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.EqualValues(t,
 		&types.GenesisState{
+			Params: types.DefaultParams(),
 			SystemInfo: types.SystemInfo{
-				NextId:        uint64(1),
-				FifoHeadIndex: "-1",
-				FifoTailIndex: "-1",
+				NextId: uint64(1),
 			},
 			StoredGameList: []types.StoredGame{},
 			PlayerInfoList: []types.PlayerInfo{},
 			Leaderboard: types.Leaderboard{
 				Winners: []types.WinningPlayer{},
 			},
+			GameMoveList: []types.GameMove{},
 		},
 		types.DefaultGenesis(),
 	)
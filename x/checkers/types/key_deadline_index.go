@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+const (
+	// DeadlineTimeIndexKeyPrefix is the prefix to retrieve the ongoing games by wall-clock deadline
+	DeadlineTimeIndexKeyPrefix = "DeadlineIndex/time/"
+	// DeadlineHeightIndexKeyPrefix is the prefix to retrieve the ongoing games by block-height deadline
+	DeadlineHeightIndexKeyPrefix = "DeadlineIndex/height/"
+	// DeadlineIndexCountKey is the key of the number of games in both deadline indices
+	DeadlineIndexCountKey = "DeadlineIndex/count/"
+)
+
+// sortableInt64 returns the big-endian bytes of the value with its sign bit flipped, so that negative values
+// sort before positive ones.
+func sortableInt64(value int64) []byte {
+	bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bytes, uint64(value)^(1<<63))
+	return bytes
+}
+
+// DeadlineTimeKey returns the part of the index key made of the deadline. As it is a prefix of all the keys
+// with this deadline, and is shorter, it sorts before them.
+func DeadlineTimeKey(deadline time.Time) []byte {
+	key := sortableInt64(deadline.Unix())
+	nanosBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(nanosBytes, uint32(deadline.Nanosecond()))
+	return append(key, nanosBytes...)
+}
+
+// DeadlineTimeIndexKey returns the store key of a game in the wall-clock deadline index
+func DeadlineTimeIndexKey(
+	deadline time.Time,
+	gameIndex string,
+) []byte {
+	key := DeadlineTimeKey(deadline)
+	key = append(key, []byte(gameIndex)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DeadlineHeightKey returns the part of the index key made of the deadline height
+func DeadlineHeightKey(height int64) []byte {
+	return sortableInt64(height)
+}
+
+// DeadlineHeightIndexKey returns the store key of a game in the block-height deadline index
+func DeadlineHeightIndexKey(
+	height int64,
+	gameIndex string,
+) []byte {
+	key := DeadlineHeightKey(height)
+	key = append(key, []byte(gameIndex)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
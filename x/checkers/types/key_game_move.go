@@ -0,0 +1,39 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// GameMoveKeyPrefix is the prefix to retrieve all GameMove
+	GameMoveKeyPrefix = "GameMove/value/"
+)
+
+// GameMovesKey returns the store key prefix under which all the moves of a game are kept
+func GameMovesKey(
+	gameIndex string,
+) []byte {
+	var key []byte
+
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GameMoveKey returns the store key to retrieve a GameMove from the index fields. The move index is big-endian
+// so that the moves of a game iterate in the order they were played.
+func GameMoveKey(
+	gameIndex string,
+	moveIndex uint64,
+) []byte {
+	key := GameMovesKey(gameIndex)
+
+	moveIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(moveIndexBytes, moveIndex)
+	key = append(key, moveIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
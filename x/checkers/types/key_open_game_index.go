@@ -0,0 +1,11 @@
+package types
+
+const (
+	// OpenGameIndexKeyPrefix is the prefix to retrieve the ongoing games that have a seat open
+	OpenGameIndexKeyPrefix = "OpenGameIndex/value/"
+)
+
+// OpenGameIndexKey returns the store key to retrieve an open game from its index
+func OpenGameIndexKey(gameIndex string) []byte {
+	return append([]byte(gameIndex), []byte("/")...)
+}
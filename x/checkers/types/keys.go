@@ -28,13 +28,17 @@ const (
 )
 
 const (
-	GameCreatedEventType      = "new-game-created" // Indicates what event type to listen to
-	GameCreatedEventCreator   = "creator"          // Subsidiary information
-	GameCreatedEventGameIndex = "game-index"       // What game is relevant
-	GameCreatedEventBlack     = "black"            // Is it relevant to me?
-	GameCreatedEventRed       = "red"              // Is it relevant to me?
-	GameCreatedEventWager     = "wager"
-	GameCreatedEventDenom     = "denom"
+	GameCreatedEventType       = "new-game-created" // Indicates what event type to listen to
+	GameCreatedEventCreator    = "creator"          // Subsidiary information
+	GameCreatedEventGameIndex  = "game-index"       // What game is relevant
+	GameCreatedEventBlack      = "black"            // Is it relevant to me?
+	GameCreatedEventRed        = "red"              // Is it relevant to me?
+	GameCreatedEventWager      = "wager"
+	GameCreatedEventDenom      = "denom"
+	GameCreatedEventBlackWager = "black-wager"
+	GameCreatedEventRedWager   = "red-wager"
+	GameCreatedEventSponsor    = "sponsor"
+	GameCreatedEventPrize      = "prize"
 )
 
 const (
@@ -47,6 +51,11 @@ const (
 	MovePlayedEventBoard     = "board"
 )
 
+const (
+	// MovePlayedEventListSeparator separates the captured coordinates of a move sequence
+	MovePlayedEventListSeparator = ","
+)
+
 const (
 	GameRejectedEventType      = "game-rejected"
 	GameRejectedEventCreator   = "creator"
@@ -54,12 +63,13 @@ const (
 )
 
 const (
-	NoFifoIndex = "-1"
+	RepetitionDrawCount = 3
 )
 
 const (
-	MaxTurnDuration = time.Duration(24 * 3_600 * 1_000_000_000)
-	DeadlineLayout  = "2006-01-02 15:04:05.999999999 +0000 UTC"
+	// DefaultTurnDuration applies to games created without a turn duration, once clamped into the governed bounds
+	DefaultTurnDuration = time.Duration(24 * 3_600 * 1_000_000_000)
+	DeadlineLayout      = "2006-01-02 15:04:05.999999999 +0000 UTC"
 )
 
 const (
@@ -70,19 +80,57 @@ const (
 )
 
 const (
-	CreateGameGas       = 15000
-	PlayMoveGas         = 1000
-	RejectGameRefundGas = 14000
+	GameDrawnEventType      = "game-drawn"
+	GameDrawnEventGameIndex = "game-index"
+	GameDrawnEventBoard     = "board"
 )
 
 const (
-	LeaderboardKey = "Leaderboard-value-"
+	DrawOfferedEventType      = "draw-offered"
+	DrawOfferedEventCreator   = "creator"
+	DrawOfferedEventGameIndex = "game-index"
 )
 
 const (
-	DateAddedLayout = DeadlineLayout
+	DrawDeclinedEventType      = "draw-declined"
+	DrawDeclinedEventCreator   = "creator"
+	DrawDeclinedEventGameIndex = "game-index"
+)
+
+const (
+	GameResignedEventType      = "game-resigned"
+	GameResignedEventCreator   = "creator"
+	GameResignedEventGameIndex = "game-index"
+	GameResignedEventWinner    = "winner"
+	GameResignedEventBoard     = "board"
+)
+
+const (
+	GameJoinedEventType      = "game-joined"
+	GameJoinedEventCreator   = "creator"
+	GameJoinedEventGameIndex = "game-index"
+	GameJoinedEventColor     = "color"
 )
 
 const (
-	LeaderboardWinnerLength = uint64(100)
+	GameAcceptedEventType      = "game-accepted"
+	GameAcceptedEventCreator   = "creator"
+	GameAcceptedEventGameIndex = "game-index"
+)
+
+const (
+	WinningsPaidEventType      = "winnings-paid"
+	WinningsPaidEventGameIndex = "game-index"
+	WinningsPaidEventWinner    = "winner"
+	WinningsPaidEventWinnings  = "winnings"
+	WinningsPaidEventFee       = "fee"
+)
+
+const (
+	LeaderboardKey = "Leaderboard-value-"
+)
+
+const (
+	DateAddedLayout = DeadlineLayout
+	BlockTimeLayout = DeadlineLayout
 )
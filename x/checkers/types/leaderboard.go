@@ -111,6 +111,7 @@ func UpdatePlayerInfoAtNow(
 	winners []WinningPlayerParsed,
 	now time.Time,
 	candidate PlayerInfo,
+	maxLength uint64,
 ) (updated []WinningPlayerParsed) {
 	if candidate.WonCount < 1 {
 		return winners
@@ -139,18 +140,18 @@ func UpdatePlayerInfoAtNow(
 		updated = winners
 	}
 	SortWinners(updated)
-	if LeaderboardWinnerLength < uint64(len(updated)) {
-		updated = updated[:LeaderboardWinnerLength]
+	if maxLength < uint64(len(updated)) {
+		updated = updated[:maxLength]
 	}
 	return updated
 }
 
-func (leaderboard *Leaderboard) UpdatePlayerInfoAtNow(now time.Time, candidate PlayerInfo) error {
+func (leaderboard *Leaderboard) UpdatePlayerInfoAtNow(now time.Time, candidate PlayerInfo, maxLength uint64) error {
 	winners, err := leaderboard.ParseWinners()
 	if err != nil {
 		return err
 	}
-	updated := UpdatePlayerInfoAtNow(winners, now, candidate)
+	updated := UpdatePlayerInfoAtNow(winners, now, candidate, maxLength)
 	leaderboard.Winners = StringifyWinners(updated)
 	candidate.Index = "fake"
 	return nil
@@ -376,7 +376,7 @@ func TestUpdatePlayerInfoAtNow(t *testing.T) {
 			leaderboard := types.Leaderboard{
 				Winners: tt.sorted,
 			}
-			err = leaderboard.UpdatePlayerInfoAtNow(now, tt.candidate)
+			err = leaderboard.UpdatePlayerInfoAtNow(now, tt.candidate, types.DefaultLeaderboardWinnerLength)
 			require.NoError(t, err)
 			require.Equal(t, len(tt.expected), len(leaderboard.Winners))
 			require.EqualValues(t, tt.expected, leaderboard.Winners)
@@ -407,9 +407,26 @@ func TestUpdatePlayerInfoAtNowTooLongNoAdd(t *testing.T) {
 	err = leaderboard.UpdatePlayerInfoAtNow(now, types.PlayerInfo{
 		Index:    "100",
 		WonCount: 1,
-	})
+	}, types.DefaultLeaderboardWinnerLength)
 	require.NoError(t, err)
 	require.Equal(t, len(beforeWinners), len(leaderboard.Winners))
 	require.EqualValues(t, beforeWinners, leaderboard.Winners)
 	require.NoError(t, leaderboard.Validate())
 }
+
+func TestUpdatePlayerInfoAtNowShorterLength(t *testing.T) {
+	beforeWinners := makeMaxLengthSortedWinningPlayers()
+	now, err := types.ParseDateAddedAsTime("2006-01-02 15:05:05.999999999 +0000 UTC")
+	require.NoError(t, err)
+	leaderboard := types.Leaderboard{
+		Winners: beforeWinners,
+	}
+	err = leaderboard.UpdatePlayerInfoAtNow(now, types.PlayerInfo{
+		Index:    "100",
+		WonCount: 200,
+	}, 3)
+	require.NoError(t, err)
+	require.Len(t, leaderboard.Winners, 3)
+	require.Equal(t, "100", leaderboard.Winners[0].PlayerAddress)
+	require.EqualValues(t, beforeWinners[:2], leaderboard.Winners[1:])
+}
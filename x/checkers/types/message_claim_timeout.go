@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgClaimTimeout = "claim_timeout"
+
+var _ sdk.Msg = &MsgClaimTimeout{}
+
+func NewMsgClaimTimeout(creator string, gameIndex string) *MsgClaimTimeout {
+	return &MsgClaimTimeout{
+		Creator:   creator,
+		GameIndex: gameIndex,
+	}
+}
+
+func (msg *MsgClaimTimeout) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimTimeout) Type() string {
+	return TypeMsgClaimTimeout
+}
+
+func (msg *MsgClaimTimeout) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgClaimTimeout) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimTimeout) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
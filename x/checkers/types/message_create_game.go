@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,13 +11,23 @@ const TypeMsgCreateGame = "create_game"
 
 var _ sdk.Msg = &MsgCreateGame{}
 
-func NewMsgCreateGame(creator string, black string, red string, wager uint64, denom string) *MsgCreateGame {
+func NewMsgCreateGame(creator string, black string, red string, wager uint64, denom string,
+	turnDuration time.Duration, timeBank time.Duration, increment time.Duration, turnBlocks uint64,
+	blackWager sdk.Coins, redWager sdk.Coins, sponsor string, prize sdk.Coins) *MsgCreateGame {
 	return &MsgCreateGame{
-		Creator: creator,
-		Black:   black,
-		Red:     red,
-		Wager:   wager,
-		Denom:   denom,
+		Creator:      creator,
+		Black:        black,
+		Red:          red,
+		Wager:        wager,
+		Denom:        denom,
+		TurnDuration: turnDuration,
+		TimeBank:     timeBank,
+		Increment:    increment,
+		TurnBlocks:   turnBlocks,
+		BlackWager:   blackWager,
+		RedWager:     redWager,
+		Sponsor:      sponsor,
+		Prize:        prize,
 	}
 }
 
@@ -32,7 +44,15 @@ func (msg *MsgCreateGame) GetSigners() []sdk.AccAddress {
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{creator}
+	signers := []sdk.AccAddress{creator}
+	if msg.IsSponsored() && msg.Sponsor != msg.Creator {
+		sponsor, err := sdk.AccAddressFromBech32(msg.Sponsor)
+		if err != nil {
+			panic(err)
+		}
+		signers = append(signers, sponsor)
+	}
+	return signers
 }
 
 func (msg *MsgCreateGame) GetSignBytes() []byte {
@@ -45,5 +65,65 @@ func (msg *MsgCreateGame) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.IsSponsored() {
+		_, err = sdk.AccAddressFromBech32(msg.Sponsor)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address (%s)", err)
+		}
+		if msg.Wager != 0 || msg.Denom != "" || msg.HasPlayerWagers() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "players of a sponsored game cannot wager")
+		}
+		if msg.Prize.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "sponsored game needs a prize")
+		}
+		if err = msg.Prize.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid prize (%s)", err)
+		}
+	} else if !msg.Prize.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "a prize needs a sponsor")
+	} else if msg.HasPlayerWagers() {
+		if msg.Wager != 0 || msg.Denom != "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "wager and denom cannot be combined with player wagers")
+		}
+		if err = msg.BlackWager.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid black wager (%s)", err)
+		}
+		if err = msg.RedWager.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid red wager (%s)", err)
+		}
+	} else if err = sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom (%s)", err)
+	}
+	if msg.TurnDuration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidTurnDuration, "%s", msg.TurnDuration)
+	}
+	if msg.TimeBank < 0 || msg.Increment < 0 || (msg.TimeBank == 0 && msg.Increment != 0) {
+		return sdkerrors.Wrapf(ErrInvalidTimeControl, "%s+%s", msg.TimeBank, msg.Increment)
+	}
+	if msg.TurnBlocks > 0 && (msg.TurnDuration != 0 || msg.TimeBank != 0) {
+		return sdkerrors.Wrapf(ErrInvalidTurnBlocks, "cannot be combined with a turn duration or a clock")
+	}
 	return nil
 }
+
+// HasPlayerWagers tells whether the players stake their own coins instead of the same wager and denom.
+func (msg *MsgCreateGame) HasPlayerWagers() bool {
+	return !msg.BlackWager.Empty() || !msg.RedWager.Empty()
+}
+
+// IsSponsored tells whether a sponsor puts up the prize instead of the players.
+func (msg *MsgCreateGame) IsSponsored() bool {
+	return msg.Sponsor != ""
+}
+
+// GetPlayerWagers returns the coins staked by each player, none in a sponsored game.
+func (msg *MsgCreateGame) GetPlayerWagers() (black sdk.Coins, red sdk.Coins) {
+	if msg.HasPlayerWagers() {
+		return msg.BlackWager, msg.RedWager
+	}
+	if msg.IsSponsored() {
+		return nil, nil
+	}
+	wager := sdk.NewCoins(sdk.NewCoin(msg.Denom, sdk.NewIntFromUint64(msg.Wager)))
+	return wager, wager
+}
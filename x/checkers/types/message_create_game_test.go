@@ -2,8 +2,10 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/alice/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -24,6 +26,122 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 			name: "valid address",
 			msg: MsgCreateGame{
 				Creator: sample.AccAddress(),
+				Denom:   "stake",
+			},
+		}, {
+			name: "invalid denom",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Denom:   "1stake",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "ibc denom",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Denom:   "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			},
+		}, {
+			name: "player wagers",
+			msg: MsgCreateGame{
+				Creator:    sample.AccAddress(),
+				BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3)),
+				RedWager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			},
+		}, {
+			name: "player wagers with denom",
+			msg: MsgCreateGame{
+				Creator:    sample.AccAddress(),
+				Denom:      "stake",
+				BlackWager: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "unsorted player wager",
+			msg: MsgCreateGame{
+				Creator:  sample.AccAddress(),
+				RedWager: sdk.Coins{sdk.NewInt64Coin("token", 3), sdk.NewInt64Coin("stake", 10)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "sponsored",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Sponsor: sample.AccAddress(),
+				Prize:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+		}, {
+			name: "invalid sponsor",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Sponsor: "invalid_address",
+				Prize:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "sponsored without prize",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Sponsor: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "sponsored with wager",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Wager:   45,
+				Denom:   "stake",
+				Sponsor: sample.AccAddress(),
+				Prize:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "prize without sponsor",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Denom:   "stake",
+				Prize:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "negative turn duration",
+			msg: MsgCreateGame{
+				Creator:      sample.AccAddress(),
+				Denom:        "stake",
+				TurnDuration: -time.Second,
+			},
+			err: ErrInvalidTurnDuration,
+		}, {
+			name: "increment without time bank",
+			msg: MsgCreateGame{
+				Creator:   sample.AccAddress(),
+				Denom:     "stake",
+				Increment: time.Second,
+			},
+			err: ErrInvalidTimeControl,
+		}, {
+			name: "time bank and increment",
+			msg: MsgCreateGame{
+				Creator:   sample.AccAddress(),
+				Denom:     "stake",
+				TimeBank:  time.Hour,
+				Increment: time.Second,
+			},
+		}, {
+			name: "turn blocks with turn duration",
+			msg: MsgCreateGame{
+				Creator:      sample.AccAddress(),
+				Denom:        "stake",
+				TurnDuration: time.Hour,
+				TurnBlocks:   100,
+			},
+			err: ErrInvalidTurnBlocks,
+		}, {
+			name: "turn blocks",
+			msg: MsgCreateGame{
+				Creator:    sample.AccAddress(),
+				Denom:      "stake",
+				TurnBlocks: 100,
 			},
 		},
 	}
@@ -38,3 +156,14 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateGame_GetSigners(t *testing.T) {
+	creator, _ := sdk.AccAddressFromBech32(sample.AccAddress())
+	sponsor, _ := sdk.AccAddressFromBech32(sample.AccAddress())
+	msg := MsgCreateGame{Creator: creator.String(), Denom: "stake"}
+	require.Equal(t, []sdk.AccAddress{creator}, msg.GetSigners())
+	msg = MsgCreateGame{Creator: creator.String(), Sponsor: creator.String()}
+	require.Equal(t, []sdk.AccAddress{creator}, msg.GetSigners())
+	msg = MsgCreateGame{Creator: creator.String(), Sponsor: sponsor.String()}
+	require.Equal(t, []sdk.AccAddress{creator, sponsor}, msg.GetSigners())
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlayMoveSequence = "play_move_sequence"
+
+var _ sdk.Msg = &MsgPlayMoveSequence{}
+
+func NewMsgPlayMoveSequence(creator string, gameIndex string, positions []Position) *MsgPlayMoveSequence {
+	return &MsgPlayMoveSequence{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Positions: positions,
+	}
+}
+
+func (msg *MsgPlayMoveSequence) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlayMoveSequence) Type() string {
+	return TypeMsgPlayMoveSequence
+}
+
+func (msg *MsgPlayMoveSequence) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlayMoveSequence) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlayMoveSequence) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Positions) < 2 {
+		return sdkerrors.Wrapf(ErrMoveSequenceTooShort, "%d", len(msg.Positions))
+	}
+	return nil
+}
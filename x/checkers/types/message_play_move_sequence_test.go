@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPlayMoveSequence_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlayMoveSequence
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlayMoveSequence{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "too few positions",
+			msg: MsgPlayMoveSequence{
+				Creator:   sample.AccAddress(),
+				Positions: []Position{{X: 1, Y: 2}},
+			},
+			err: ErrMoveSequenceTooShort,
+		}, {
+			name: "valid address",
+			msg: MsgPlayMoveSequence{
+				Creator:   sample.AccAddress(),
+				Positions: []Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,34 +1,201 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyNoProgressMoveLimit = []byte("NoProgressMoveLimit")
+	// DefaultNoProgressMoveLimit is 40 moves by each player. 0 disables the rule.
+	DefaultNoProgressMoveLimit uint64 = 80
+	KeyMinTurnDuration                = []byte("MinTurnDuration")
+	DefaultMinTurnDuration            = time.Minute
+	KeyMaxTurnDuration                = []byte("MaxTurnDuration")
+	DefaultMaxTurnDuration            = 28 * 24 * time.Hour
+	KeyMinTurnBlocks                  = []byte("MinTurnBlocks")
+	DefaultMinTurnBlocks       uint64 = 10
+	KeyMaxTurnBlocks                  = []byte("MaxTurnBlocks")
+	// DefaultMaxTurnBlocks is about 4 weeks of 5-second blocks
+	DefaultMaxTurnBlocks   uint64 = 500_000
+	KeyMaxForfeitsPerBlock        = []byte("MaxForfeitsPerBlock")
+	// DefaultMaxForfeitsPerBlock caps the work of EndBlock, the rest of the expired games wait for the next blocks.
+	// 0 disables the sweep, leaving expired games to MsgClaimTimeout.
+	DefaultMaxForfeitsPerBlock uint64 = 100
+	KeyInvitationDuration             = []byte("InvitationDuration")
+	DefaultInvitationDuration         = 24 * time.Hour
+	KeyUpFrontEscrow                  = []byte("UpFrontEscrow")
+	// DefaultUpFrontEscrow keeps collecting each wager on the first move of its player.
+	DefaultUpFrontEscrow = false
+	KeyCreateGameGas     = []byte("CreateGameGas")
+	// DefaultCreateGameGas is consumed on top of the storage costs, and mostly refunded on rejection
+	DefaultCreateGameGas   uint64 = 15_000
+	KeyPlayMoveGas                = []byte("PlayMoveGas")
+	DefaultPlayMoveGas     uint64 = 1_000
+	KeyRejectGameRefundGas        = []byte("RejectGameRefundGas")
+	// DefaultRejectGameRefundGas is capped by the gas actually consumed by the rejection
+	DefaultRejectGameRefundGas     uint64 = 14_000
+	KeyLeaderboardWinnerLength            = []byte("LeaderboardWinnerLength")
+	DefaultLeaderboardWinnerLength uint64 = 100
+	KeyRake                               = []byte("Rake")
+	// DefaultRake pays out the winnings in full
+	DefaultRake      = sdk.ZeroDec()
+	KeyRakeRecipient = []byte("RakeRecipient")
+	// DefaultRakeRecipient sends the rake to the community pool. Any other recipient has to be a module account
+	// known to the app.
+	DefaultRakeRecipient = ""
+	KeyWagerDenoms       = []byte("WagerDenoms")
+	// DefaultWagerDenoms only accepts wagers in the staking denom, without bounds
+	DefaultWagerDenoms = []WagerDenom{{Denom: sdk.DefaultBondDenom}}
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	noProgressMoveLimit uint64,
+	minTurnDuration time.Duration,
+	maxTurnDuration time.Duration,
+	minTurnBlocks uint64,
+	maxTurnBlocks uint64,
+	maxForfeitsPerBlock uint64,
+	invitationDuration time.Duration,
+	upFrontEscrow bool,
+	createGameGas uint64,
+	playMoveGas uint64,
+	rejectGameRefundGas uint64,
+	leaderboardWinnerLength uint64,
+	rake sdk.Dec,
+	rakeRecipient string,
+	wagerDenoms []WagerDenom,
+) Params {
+	return Params{
+		NoProgressMoveLimit:     noProgressMoveLimit,
+		MinTurnDuration:         minTurnDuration,
+		MaxTurnDuration:         maxTurnDuration,
+		MinTurnBlocks:           minTurnBlocks,
+		MaxTurnBlocks:           maxTurnBlocks,
+		MaxForfeitsPerBlock:     maxForfeitsPerBlock,
+		InvitationDuration:      invitationDuration,
+		UpFrontEscrow:           upFrontEscrow,
+		CreateGameGas:           createGameGas,
+		PlayMoveGas:             playMoveGas,
+		RejectGameRefundGas:     rejectGameRefundGas,
+		LeaderboardWinnerLength: leaderboardWinnerLength,
+		Rake:                    rake,
+		RakeRecipient:           rakeRecipient,
+		WagerDenoms:             wagerDenoms,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultNoProgressMoveLimit,
+		DefaultMinTurnDuration,
+		DefaultMaxTurnDuration,
+		DefaultMinTurnBlocks,
+		DefaultMaxTurnBlocks,
+		DefaultMaxForfeitsPerBlock,
+		DefaultInvitationDuration,
+		DefaultUpFrontEscrow,
+		DefaultCreateGameGas,
+		DefaultPlayMoveGas,
+		DefaultRejectGameRefundGas,
+		DefaultLeaderboardWinnerLength,
+		DefaultRake,
+		DefaultRakeRecipient,
+		DefaultWagerDenoms,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyNoProgressMoveLimit, &p.NoProgressMoveLimit, validateNoProgressMoveLimit),
+		paramtypes.NewParamSetPair(KeyMinTurnDuration, &p.MinTurnDuration, validateTurnDuration),
+		paramtypes.NewParamSetPair(KeyMaxTurnDuration, &p.MaxTurnDuration, validateTurnDuration),
+		paramtypes.NewParamSetPair(KeyMinTurnBlocks, &p.MinTurnBlocks, validateTurnBlocks),
+		paramtypes.NewParamSetPair(KeyMaxTurnBlocks, &p.MaxTurnBlocks, validateTurnBlocks),
+		paramtypes.NewParamSetPair(KeyMaxForfeitsPerBlock, &p.MaxForfeitsPerBlock, validateMaxForfeitsPerBlock),
+		paramtypes.NewParamSetPair(KeyInvitationDuration, &p.InvitationDuration, validateInvitationDuration),
+		paramtypes.NewParamSetPair(KeyUpFrontEscrow, &p.UpFrontEscrow, validateUpFrontEscrow),
+		paramtypes.NewParamSetPair(KeyCreateGameGas, &p.CreateGameGas, validateGas),
+		paramtypes.NewParamSetPair(KeyPlayMoveGas, &p.PlayMoveGas, validateGas),
+		paramtypes.NewParamSetPair(KeyRejectGameRefundGas, &p.RejectGameRefundGas, validateGas),
+		paramtypes.NewParamSetPair(KeyLeaderboardWinnerLength, &p.LeaderboardWinnerLength, validateLeaderboardWinnerLength),
+		paramtypes.NewParamSetPair(KeyRake, &p.Rake, validateRake),
+		paramtypes.NewParamSetPair(KeyRakeRecipient, &p.RakeRecipient, validateRakeRecipient),
+		paramtypes.NewParamSetPair(KeyWagerDenoms, &p.WagerDenoms, validateWagerDenoms),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateNoProgressMoveLimit(p.NoProgressMoveLimit); err != nil {
+		return err
+	}
+	if err := validateTurnDuration(p.MinTurnDuration); err != nil {
+		return err
+	}
+	if err := validateTurnDuration(p.MaxTurnDuration); err != nil {
+		return err
+	}
+	if p.MinTurnDuration > p.MaxTurnDuration {
+		return fmt.Errorf("min turn duration %s is above max turn duration %s", p.MinTurnDuration, p.MaxTurnDuration)
+	}
+	if err := validateTurnBlocks(p.MinTurnBlocks); err != nil {
+		return err
+	}
+	if err := validateTurnBlocks(p.MaxTurnBlocks); err != nil {
+		return err
+	}
+	if p.MinTurnBlocks > p.MaxTurnBlocks {
+		return fmt.Errorf("min turn blocks %d is above max turn blocks %d", p.MinTurnBlocks, p.MaxTurnBlocks)
+	}
+	if err := validateMaxForfeitsPerBlock(p.MaxForfeitsPerBlock); err != nil {
+		return err
+	}
+	if err := validateInvitationDuration(p.InvitationDuration); err != nil {
+		return err
+	}
+	if err := validateUpFrontEscrow(p.UpFrontEscrow); err != nil {
+		return err
+	}
+	if err := validateGas(p.CreateGameGas); err != nil {
+		return err
+	}
+	if err := validateGas(p.PlayMoveGas); err != nil {
+		return err
+	}
+	if err := validateGas(p.RejectGameRefundGas); err != nil {
+		return err
+	}
+	if p.RejectGameRefundGas > p.CreateGameGas {
+		return fmt.Errorf("reject game refund gas %d is above create game gas %d", p.RejectGameRefundGas, p.CreateGameGas)
+	}
+	if err := validateLeaderboardWinnerLength(p.LeaderboardWinnerLength); err != nil {
+		return err
+	}
+	if err := validateRake(p.Rake); err != nil {
+		return err
+	}
+	if err := validateRakeRecipient(p.RakeRecipient); err != nil {
+		return err
+	}
+	if err := validateWagerDenoms(p.WagerDenoms); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -37,3 +204,144 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateNoProgressMoveLimit validates the NoProgressMoveLimit param
+func validateNoProgressMoveLimit(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateTurnDuration validates the MinTurnDuration and MaxTurnDuration params
+func validateTurnDuration(v interface{}) error {
+	turnDuration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if turnDuration <= 0 {
+		return fmt.Errorf("turn duration must be positive: %s", turnDuration)
+	}
+
+	return nil
+}
+
+// validateTurnBlocks validates the MinTurnBlocks and MaxTurnBlocks params
+func validateTurnBlocks(v interface{}) error {
+	turnBlocks, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if turnBlocks == 0 {
+		return fmt.Errorf("turn blocks must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxForfeitsPerBlock validates the MaxForfeitsPerBlock param
+func validateMaxForfeitsPerBlock(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateInvitationDuration validates the InvitationDuration param
+func validateInvitationDuration(v interface{}) error {
+	invitationDuration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if invitationDuration <= 0 {
+		return fmt.Errorf("invitation duration must be positive: %s", invitationDuration)
+	}
+
+	return nil
+}
+
+// validateUpFrontEscrow validates the UpFrontEscrow param
+func validateUpFrontEscrow(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateGas validates the CreateGameGas, PlayMoveGas and RejectGameRefundGas params
+func validateGas(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateLeaderboardWinnerLength validates the LeaderboardWinnerLength param
+func validateLeaderboardWinnerLength(v interface{}) error {
+	leaderboardWinnerLength, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if leaderboardWinnerLength == 0 {
+		return fmt.Errorf("leaderboard winner length must be positive")
+	}
+
+	return nil
+}
+
+// validateRake validates the Rake param
+func validateRake(v interface{}) error {
+	rake, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if rake.IsNil() {
+		return fmt.Errorf("rake must not be nil")
+	}
+	if rake.IsNegative() {
+		return fmt.Errorf("rake must not be negative: %s", rake)
+	}
+	if rake.GTE(sdk.OneDec()) {
+		return fmt.Errorf("rake must be below 1: %s", rake)
+	}
+
+	return nil
+}
+
+// validateRakeRecipient validates the RakeRecipient param
+func validateRakeRecipient(v interface{}) error {
+	_, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateWagerDenoms validates the WagerDenoms param
+func validateWagerDenoms(v interface{}) error {
+	wagerDenoms, ok := v.([]WagerDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]bool, len(wagerDenoms))
+	for _, wagerDenom := range wagerDenoms {
+		if err := sdk.ValidateDenom(wagerDenom.Denom); err != nil {
+			return err
+		}
+		if seen[wagerDenom.Denom] {
+			return fmt.Errorf("duplicate wager denom: %s", wagerDenom.Denom)
+		}
+		seen[wagerDenom.Denom] = true
+		if wagerDenom.MaxWager != 0 && wagerDenom.MaxWager < wagerDenom.MinWager {
+			return fmt.Errorf("max wager %d is below min wager %d for denom %s",
+				wagerDenom.MaxWager, wagerDenom.MinWager, wagerDenom.Denom)
+		}
+	}
+
+	return nil
+}
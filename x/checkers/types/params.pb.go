@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	NoProgressMoveLimit     uint64        `protobuf:"varint,1,opt,name=noProgressMoveLimit,proto3" json:"noProgressMoveLimit,omitempty" yaml:"no_progress_move_limit"`
+	MinTurnDuration         time.Duration `protobuf:"bytes,2,opt,name=minTurnDuration,proto3,stdduration" json:"minTurnDuration" yaml:"min_turn_duration"`
+	MaxTurnDuration         time.Duration `protobuf:"bytes,3,opt,name=maxTurnDuration,proto3,stdduration" json:"maxTurnDuration" yaml:"max_turn_duration"`
+	MinTurnBlocks           uint64        `protobuf:"varint,4,opt,name=minTurnBlocks,proto3" json:"minTurnBlocks,omitempty" yaml:"min_turn_blocks"`
+	MaxTurnBlocks           uint64        `protobuf:"varint,5,opt,name=maxTurnBlocks,proto3" json:"maxTurnBlocks,omitempty" yaml:"max_turn_blocks"`
+	MaxForfeitsPerBlock     uint64        `protobuf:"varint,6,opt,name=maxForfeitsPerBlock,proto3" json:"maxForfeitsPerBlock,omitempty" yaml:"max_forfeits_per_block"`
+	InvitationDuration      time.Duration `protobuf:"bytes,7,opt,name=invitationDuration,proto3,stdduration" json:"invitationDuration" yaml:"invitation_duration"`
+	UpFrontEscrow           bool          `protobuf:"varint,8,opt,name=upFrontEscrow,proto3" json:"upFrontEscrow,omitempty" yaml:"up_front_escrow"`
+	CreateGameGas           uint64        `protobuf:"varint,9,opt,name=createGameGas,proto3" json:"createGameGas,omitempty" yaml:"create_game_gas"`
+	PlayMoveGas             uint64        `protobuf:"varint,10,opt,name=playMoveGas,proto3" json:"playMoveGas,omitempty" yaml:"play_move_gas"`
+	RejectGameRefundGas     uint64        `protobuf:"varint,11,opt,name=rejectGameRefundGas,proto3" json:"rejectGameRefundGas,omitempty" yaml:"reject_game_refund_gas"`
+	LeaderboardWinnerLength uint64        `protobuf:"varint,12,opt,name=leaderboardWinnerLength,proto3" json:"leaderboardWinnerLength,omitempty" yaml:"leaderboard_winner_length"`
+	// Share of the winner's pot kept as a fee
+	Rake github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=rake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rake" yaml:"rake"`
+	// Module account receiving the rake, the community pool when empty
+	RakeRecipient string `protobuf:"bytes,14,opt,name=rakeRecipient,proto3" json:"rakeRecipient,omitempty" yaml:"rake_recipient"`
+	// Denoms accepted as wagers, IBC vouchers included
+	WagerDenoms []WagerDenom `protobuf:"bytes,15,rep,name=wagerDenoms,proto3" json:"wagerDenoms" yaml:"wager_denoms"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,23 +82,222 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetNoProgressMoveLimit() uint64 {
+	if m != nil {
+		return m.NoProgressMoveLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMinTurnDuration() time.Duration {
+	if m != nil {
+		return m.MinTurnDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTurnDuration() time.Duration {
+	if m != nil {
+		return m.MaxTurnDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinTurnBlocks() uint64 {
+	if m != nil {
+		return m.MinTurnBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTurnBlocks() uint64 {
+	if m != nil {
+		return m.MaxTurnBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxForfeitsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxForfeitsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetInvitationDuration() time.Duration {
+	if m != nil {
+		return m.InvitationDuration
+	}
+	return 0
+}
+
+func (m *Params) GetUpFrontEscrow() bool {
+	if m != nil {
+		return m.UpFrontEscrow
+	}
+	return false
+}
+
+func (m *Params) GetCreateGameGas() uint64 {
+	if m != nil {
+		return m.CreateGameGas
+	}
+	return 0
+}
+
+func (m *Params) GetPlayMoveGas() uint64 {
+	if m != nil {
+		return m.PlayMoveGas
+	}
+	return 0
+}
+
+func (m *Params) GetRejectGameRefundGas() uint64 {
+	if m != nil {
+		return m.RejectGameRefundGas
+	}
+	return 0
+}
+
+func (m *Params) GetLeaderboardWinnerLength() uint64 {
+	if m != nil {
+		return m.LeaderboardWinnerLength
+	}
+	return 0
+}
+
+func (m *Params) GetRakeRecipient() string {
+	if m != nil {
+		return m.RakeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetWagerDenoms() []WagerDenom {
+	if m != nil {
+		return m.WagerDenoms
+	}
+	return nil
+}
+
+// WagerDenom bounds the wagers of games created in a given denom.
+type WagerDenom struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinWager uint64 `protobuf:"varint,2,opt,name=minWager,proto3" json:"minWager,omitempty" yaml:"min_wager"`
+	// 0 means no maximum
+	MaxWager uint64 `protobuf:"varint,3,opt,name=maxWager,proto3" json:"maxWager,omitempty" yaml:"max_wager"`
+}
+
+func (m *WagerDenom) Reset()         { *m = WagerDenom{} }
+func (m *WagerDenom) String() string { return proto.CompactTextString(m) }
+func (*WagerDenom) ProtoMessage()    {}
+func (*WagerDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ec14988318ba9aaa, []int{1}
+}
+func (m *WagerDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WagerDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WagerDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WagerDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WagerDenom.Merge(m, src)
+}
+func (m *WagerDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *WagerDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_WagerDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WagerDenom proto.InternalMessageInfo
+
+func (m *WagerDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *WagerDenom) GetMinWager() uint64 {
+	if m != nil {
+		return m.MinWager
+	}
+	return 0
+}
+
+func (m *WagerDenom) GetMaxWager() uint64 {
+	if m != nil {
+		return m.MaxWager
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "alice.checkers.checkers.Params")
+	proto.RegisterType((*WagerDenom)(nil), "alice.checkers.checkers.WagerDenom")
 }
 
 func init() { proto.RegisterFile("checkers/params.proto", fileDescriptor_ec14988318ba9aaa) }
 
 var fileDescriptor_ec14988318ba9aaa = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0x49, 0xc2, 0x19, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58,
-	0xbb, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f,
-	0xb6, 0x43, 0x1f, 0xee, 0x80, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c,
-	0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x26, 0xe7, 0x98, 0xa4, 0x00, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x9a, 0x96, 0x66, 0x43, 0x29, 0x72, 0x53, 0xea, 0x06, 0x11, 0x07, 0x53, 0x55,
+	0x11, 0x12, 0x0e, 0x2a, 0xb7, 0x4a, 0x08, 0x14, 0x85, 0xf6, 0x52, 0xa4, 0x62, 0x90, 0x2a, 0x71,
+	0xc0, 0xda, 0x38, 0x1b, 0x77, 0x89, 0xbd, 0x6b, 0xd6, 0x76, 0xeb, 0xbe, 0x00, 0x67, 0x8e, 0x3d,
+	0xf2, 0x38, 0x3d, 0xf6, 0x88, 0x38, 0x18, 0xd4, 0xbe, 0x81, 0x9f, 0x00, 0xed, 0xae, 0xf3, 0xcf,
+	0x4d, 0x85, 0xb8, 0xc4, 0x6b, 0xcf, 0xf7, 0xfd, 0x66, 0x3c, 0xb3, 0xde, 0x80, 0x75, 0xe7, 0x18,
+	0x39, 0x43, 0xc4, 0xc2, 0x76, 0x00, 0x19, 0xf4, 0x43, 0x33, 0x60, 0x34, 0xa2, 0xea, 0x06, 0xf4,
+	0xb0, 0x83, 0xcc, 0x51, 0x70, 0xbc, 0xa8, 0xd7, 0x5c, 0xea, 0x52, 0xa1, 0x69, 0xf3, 0x95, 0x94,
+	0xd7, 0x1b, 0x2e, 0xa5, 0xae, 0x87, 0xda, 0xe2, 0xae, 0x17, 0x0f, 0xda, 0xfd, 0x98, 0xc1, 0x08,
+	0x53, 0x22, 0xe3, 0x46, 0x56, 0x01, 0x4b, 0x87, 0x82, 0xaf, 0x7e, 0x00, 0x6b, 0x84, 0x1e, 0x32,
+	0xea, 0x32, 0x14, 0x86, 0xef, 0xe8, 0x09, 0x3a, 0xc0, 0x3e, 0x8e, 0x34, 0xa5, 0xa9, 0xb4, 0xca,
+	0x9d, 0x27, 0x59, 0xaa, 0x3f, 0x3e, 0x83, 0xbe, 0xb7, 0x6b, 0x10, 0x6a, 0x07, 0xb9, 0xca, 0xf6,
+	0xe9, 0x09, 0xb2, 0x3d, 0xae, 0x33, 0xac, 0x79, 0x6e, 0x15, 0x83, 0x55, 0x1f, 0x93, 0x8f, 0x31,
+	0x23, 0xdd, 0x3c, 0xb1, 0x76, 0xa7, 0xa9, 0xb4, 0xaa, 0x3b, 0x9b, 0xa6, 0xac, 0xcc, 0x1c, 0x55,
+	0x66, 0x8e, 0x04, 0x9d, 0xad, 0x8b, 0x54, 0x2f, 0x65, 0xa9, 0xae, 0xc9, 0x7c, 0x3e, 0x26, 0x76,
+	0x14, 0x33, 0x62, 0x8f, 0x4a, 0x37, 0xce, 0x7f, 0xeb, 0x8a, 0x55, 0xe4, 0x8a, 0x54, 0x30, 0x99,
+	0x49, 0xb5, 0xf0, 0xbf, 0xa9, 0x60, 0x32, 0x3f, 0xd5, 0x2c, 0x57, 0x7d, 0x03, 0x56, 0xf2, 0xec,
+	0x1d, 0x8f, 0x3a, 0xc3, 0x50, 0x2b, 0x8b, 0x26, 0xd5, 0xb3, 0x54, 0x7f, 0x58, 0x28, 0xba, 0x27,
+	0x04, 0x86, 0x35, 0x6b, 0x10, 0x04, 0x98, 0x4c, 0x1e, 0x68, 0x8b, 0x37, 0x08, 0x30, 0x29, 0x12,
+	0xa6, 0x0d, 0x7c, 0x5c, 0x3e, 0x4c, 0xf6, 0x28, 0x1b, 0x20, 0x1c, 0x85, 0x87, 0x88, 0x89, 0xe7,
+	0xda, 0x52, 0x71, 0x5c, 0x9c, 0x33, 0xc8, 0x55, 0x76, 0x80, 0x98, 0xe4, 0x19, 0xd6, 0x3c, 0xb7,
+	0xfa, 0x15, 0xa8, 0x98, 0x9c, 0xe0, 0x48, 0xbc, 0xe6, 0xb8, 0x8d, 0x77, 0xff, 0xd5, 0xc6, 0xed,
+	0xbc, 0x8d, 0x75, 0x99, 0x72, 0x82, 0x28, 0x34, 0x72, 0x0e, 0x9c, 0x77, 0x22, 0x0e, 0xf6, 0x18,
+	0x25, 0xd1, 0xdb, 0xd0, 0x61, 0xf4, 0x54, 0x5b, 0x6e, 0x2a, 0xad, 0xe5, 0xe9, 0x4e, 0xc4, 0x81,
+	0x3d, 0xe0, 0x71, 0x1b, 0x09, 0x81, 0x61, 0xcd, 0x1a, 0x38, 0xc1, 0x61, 0x08, 0x46, 0x68, 0x1f,
+	0xfa, 0x68, 0x1f, 0x86, 0x5a, 0xa5, 0xd8, 0x4b, 0x19, 0xb6, 0x5d, 0xe8, 0xf3, 0x1f, 0xde, 0xcb,
+	0x19, 0x83, 0xba, 0x0b, 0xaa, 0x81, 0x07, 0xcf, 0xf8, 0xb6, 0xe5, 0x7e, 0x20, 0xfc, 0x5a, 0x96,
+	0xea, 0x35, 0xe9, 0xe7, 0x41, 0xb9, 0xd7, 0x85, 0x7b, 0x5a, 0xcc, 0xe7, 0xc0, 0xd0, 0x17, 0xe4,
+	0x44, 0x1c, 0x66, 0xa1, 0x41, 0x4c, 0xfa, 0x9c, 0x51, 0x2d, 0xce, 0x41, 0x8a, 0x64, 0x0d, 0x4c,
+	0xc8, 0x24, 0x6c, 0x9e, 0x5b, 0xfd, 0x0c, 0x36, 0x3c, 0x04, 0xfb, 0x88, 0xf5, 0x28, 0x64, 0xfd,
+	0x23, 0x4c, 0x08, 0x62, 0x07, 0x88, 0xb8, 0xd1, 0xb1, 0x76, 0x4f, 0x80, 0xb7, 0xb2, 0x54, 0x6f,
+	0x4a, 0xf0, 0x94, 0xd0, 0x3e, 0x15, 0x4a, 0xdb, 0x13, 0x52, 0xc3, 0xba, 0x0d, 0xa2, 0xbe, 0x07,
+	0x65, 0x06, 0x87, 0x48, 0x5b, 0x69, 0x2a, 0xad, 0x4a, 0xe7, 0x15, 0x1f, 0xdf, 0xaf, 0x54, 0xdf,
+	0x76, 0x71, 0x74, 0x1c, 0xf7, 0x4c, 0x87, 0xfa, 0x6d, 0x87, 0x86, 0x3e, 0x0d, 0xf3, 0xcb, 0xf3,
+	0xb0, 0x3f, 0x6c, 0x47, 0x67, 0x01, 0x0a, 0xcd, 0x2e, 0x72, 0xb2, 0x54, 0xaf, 0xe6, 0xef, 0x04,
+	0x87, 0xc8, 0xb0, 0x04, 0x4a, 0x7d, 0x0d, 0x56, 0xf8, 0xd5, 0x42, 0x0e, 0x0e, 0x30, 0x22, 0x91,
+	0x76, 0x5f, 0xb0, 0x37, 0xb3, 0x54, 0x5f, 0x9f, 0xa8, 0x6d, 0x36, 0x8a, 0x1b, 0xd6, 0xac, 0x5e,
+	0xed, 0x81, 0xea, 0x29, 0x74, 0x11, 0xeb, 0x22, 0x42, 0xfd, 0x50, 0x5b, 0x6d, 0x2e, 0xb4, 0xaa,
+	0x3b, 0x4f, 0xcd, 0x5b, 0xce, 0x3b, 0xf3, 0x68, 0xac, 0xed, 0x3c, 0xca, 0xb7, 0xdf, 0x9a, 0xcc,
+	0x23, 0x28, 0x76, 0x5f, 0x60, 0x0c, 0x6b, 0x1a, 0xba, 0x5b, 0x3e, 0xff, 0xa1, 0x97, 0x8c, 0x6f,
+	0x0a, 0x00, 0x13, 0xbb, 0x5a, 0x03, 0x8b, 0x42, 0x2c, 0x8e, 0xba, 0x8a, 0x25, 0x6f, 0xd4, 0x17,
+	0x60, 0xd9, 0xc7, 0x44, 0xc8, 0xc4, 0x91, 0x55, 0xee, 0xd4, 0xb2, 0x54, 0x7f, 0x30, 0xf9, 0xbc,
+	0x05, 0xd7, 0xb0, 0xc6, 0x2a, 0xe1, 0x80, 0x89, 0x74, 0x2c, 0xdc, 0x70, 0xc0, 0x64, 0xe2, 0xc8,
+	0x55, 0x9d, 0xee, 0xc5, 0x55, 0x43, 0xb9, 0xbc, 0x6a, 0x28, 0x7f, 0xae, 0x1a, 0xca, 0xf7, 0xeb,
+	0x46, 0xe9, 0xf2, 0xba, 0x51, 0xfa, 0x79, 0xdd, 0x28, 0x7d, 0x7a, 0x36, 0x35, 0x0a, 0xd1, 0x81,
+	0xf6, 0xf8, 0xef, 0x20, 0x99, 0x2c, 0xc5, 0x48, 0x7a, 0x4b, 0xe2, 0x83, 0x7c, 0xf9, 0x77, 0x00,
+	0x35, 0x0d, 0x31, 0xd0, 0x32, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +320,151 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WagerDenoms) > 0 {
+		for iNdEx := len(m.WagerDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WagerDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RakeRecipient) > 0 {
+		i -= len(m.RakeRecipient)
+		copy(dAtA[i:], m.RakeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RakeRecipient)))
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size := m.Rake.Size()
+		i -= size
+		if _, err := m.Rake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.LeaderboardWinnerLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LeaderboardWinnerLength))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RejectGameRefundGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RejectGameRefundGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PlayMoveGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PlayMoveGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CreateGameGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CreateGameGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpFrontEscrow {
+		i--
+		if m.UpFrontEscrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InvitationDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InvitationDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxForfeitsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxForfeitsPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTurnBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTurnBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinTurnBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTurnBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTurnDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTurnDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinTurnDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTurnDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.NoProgressMoveLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NoProgressMoveLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WagerDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WagerDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WagerDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWager != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWager))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinWager != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinWager))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +485,70 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.NoProgressMoveLimit != 0 {
+		n += 1 + sovParams(uint64(m.NoProgressMoveLimit))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTurnDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTurnDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinTurnBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinTurnBlocks))
+	}
+	if m.MaxTurnBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxTurnBlocks))
+	}
+	if m.MaxForfeitsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxForfeitsPerBlock))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InvitationDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.UpFrontEscrow {
+		n += 2
+	}
+	if m.CreateGameGas != 0 {
+		n += 1 + sovParams(uint64(m.CreateGameGas))
+	}
+	if m.PlayMoveGas != 0 {
+		n += 1 + sovParams(uint64(m.PlayMoveGas))
+	}
+	if m.RejectGameRefundGas != 0 {
+		n += 1 + sovParams(uint64(m.RejectGameRefundGas))
+	}
+	if m.LeaderboardWinnerLength != 0 {
+		n += 1 + sovParams(uint64(m.LeaderboardWinnerLength))
+	}
+	l = m.Rake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.RakeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.WagerDenoms) > 0 {
+		for _, e := range m.WagerDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WagerDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinWager != 0 {
+		n += 1 + sovParams(uint64(m.MinWager))
+	}
+	if m.MaxWager != 0 {
+		n += 1 + sovParams(uint64(m.MaxWager))
+	}
 	return n
 }
 
@@ -156,6 +587,497 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProgressMoveLimit", wireType)
+			}
+			m.NoProgressMoveLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoProgressMoveLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTurnDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinTurnDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTurnDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTurnDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTurnBlocks", wireType)
+			}
+			m.MinTurnBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTurnBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTurnBlocks", wireType)
+			}
+			m.MaxTurnBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTurnBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForfeitsPerBlock", wireType)
+			}
+			m.MaxForfeitsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForfeitsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InvitationDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFrontEscrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpFrontEscrow = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateGameGas", wireType)
+			}
+			m.CreateGameGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateGameGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayMoveGas", wireType)
+			}
+			m.PlayMoveGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlayMoveGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectGameRefundGas", wireType)
+			}
+			m.RejectGameRefundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectGameRefundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderboardWinnerLength", wireType)
+			}
+			m.LeaderboardWinnerLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderboardWinnerLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RakeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RakeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WagerDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WagerDenoms = append(m.WagerDenoms, WagerDenom{})
+			if err := m.WagerDenoms[len(m.WagerDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WagerDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WagerDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WagerDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWager", wireType)
+			}
+			m.MinWager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinWager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWager", wireType)
+			}
+			m.MaxWager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParamsValid(t *testing.T) {
+	require.Nil(t, types.DefaultParams().Validate())
+}
+
+func TestParamsRejectRefundAboveCreateGas(t *testing.T) {
+	params := types.DefaultParams()
+	params.RejectGameRefundGas = params.CreateGameGas + 1
+	require.EqualError(t, params.Validate(), "reject game refund gas 15001 is above create game gas 15000")
+}
+
+func TestParamsLeaderboardWinnerLengthZero(t *testing.T) {
+	params := types.DefaultParams()
+	params.LeaderboardWinnerLength = 0
+	require.EqualError(t, params.Validate(), "leaderboard winner length must be positive")
+}
+
+func TestParamsMinAboveMaxTurnDuration(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxTurnDuration = params.MinTurnDuration / 2
+	require.EqualError(t, params.Validate(), "min turn duration 1m0s is above max turn duration 30s")
+}
+
+func TestParamsRakeOutOfBounds(t *testing.T) {
+	params := types.DefaultParams()
+	params.Rake = sdk.OneDec()
+	require.EqualError(t, params.Validate(), "rake must be below 1: 1.000000000000000000")
+	params.Rake = sdk.NewDecWithPrec(-1, 2)
+	require.EqualError(t, params.Validate(), "rake must not be negative: -0.010000000000000000")
+	params.Rake = sdk.Dec{}
+	require.EqualError(t, params.Validate(), "rake must not be nil")
+}
+
+func TestParamsWagerDenomsInvalid(t *testing.T) {
+	params := types.DefaultParams()
+	params.WagerDenoms = []types.WagerDenom{{Denom: "1stake"}}
+	require.EqualError(t, params.Validate(), "invalid denom: 1stake")
+	params.WagerDenoms = []types.WagerDenom{{Denom: "stake"}, {Denom: "stake", MinWager: 1}}
+	require.EqualError(t, params.Validate(), "duplicate wager denom: stake")
+	params.WagerDenoms = []types.WagerDenom{{Denom: "stake", MinWager: 10, MaxWager: 9}}
+	require.EqualError(t, params.Validate(), "max wager 9 is below min wager 10 for denom stake")
+}
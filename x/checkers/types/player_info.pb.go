@@ -27,6 +27,8 @@ type PlayerInfo struct {
 	WonCount       uint64 `protobuf:"varint,2,opt,name=wonCount,proto3" json:"wonCount,omitempty"`
 	LostCount      uint64 `protobuf:"varint,3,opt,name=lostCount,proto3" json:"lostCount,omitempty"`
 	ForfeitedCount uint64 `protobuf:"varint,4,opt,name=forfeitedCount,proto3" json:"forfeitedCount,omitempty"`
+	DrawnCount     uint64 `protobuf:"varint,5,opt,name=drawnCount,proto3" json:"drawnCount,omitempty"`
+	ResignedCount  uint64 `protobuf:"varint,6,opt,name=resignedCount,proto3" json:"resignedCount,omitempty"`
 }
 
 func (m *PlayerInfo) Reset()         { *m = PlayerInfo{} }
@@ -90,6 +92,20 @@ func (m *PlayerInfo) GetForfeitedCount() uint64 {
 	return 0
 }
 
+func (m *PlayerInfo) GetDrawnCount() uint64 {
+	if m != nil {
+		return m.DrawnCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetResignedCount() uint64 {
+	if m != nil {
+		return m.ResignedCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PlayerInfo)(nil), "alice.checkers.checkers.PlayerInfo")
 }
@@ -97,20 +113,22 @@ func init() {
 func init() { proto.RegisterFile("checkers/player_info.proto", fileDescriptor_11be7192ff7df15e) }
 
 var fileDescriptor_11be7192ff7df15e = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 238 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0xc8, 0x49, 0xac, 0x4c, 0x2d, 0x8a, 0xcf, 0xcc, 0x4b, 0xcb,
 	0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0xa9,
-	0x80, 0x33, 0x94, 0x5a, 0x18, 0xb9, 0xb8, 0x02, 0xc0, 0xca, 0x3d, 0xf3, 0xd2, 0xf2, 0x85, 0x44,
-	0xb8, 0x58, 0x33, 0xf3, 0x52, 0x52, 0x2b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x20, 0x1c,
-	0x21, 0x29, 0x2e, 0x8e, 0xf2, 0xfc, 0x3c, 0xe7, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x26, 0x05, 0x46,
-	0x0d, 0x96, 0x20, 0x38, 0x5f, 0x48, 0x86, 0x8b, 0x33, 0x27, 0xbf, 0xb8, 0x04, 0x22, 0xc9, 0x0c,
-	0x96, 0x44, 0x08, 0x08, 0xa9, 0x71, 0xf1, 0xa5, 0xe5, 0x17, 0xa5, 0xa5, 0x66, 0x96, 0xa4, 0xa6,
-	0x40, 0x94, 0xb0, 0x80, 0x95, 0xa0, 0x89, 0x3a, 0xb9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x56, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e,
-	0xd8, 0x13, 0xfa, 0x70, 0x6f, 0x56, 0x20, 0x98, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0xcf, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc5, 0x44, 0xf3, 0x0a, 0x01, 0x00, 0x00,
+	0x80, 0x33, 0x94, 0x4e, 0x31, 0x72, 0x71, 0x05, 0x80, 0x95, 0x7b, 0xe6, 0xa5, 0xe5, 0x0b, 0x89,
+	0x70, 0xb1, 0x66, 0xe6, 0xa5, 0xa4, 0x56, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x38,
+	0x42, 0x52, 0x5c, 0x1c, 0xe5, 0xf9, 0x79, 0xce, 0xf9, 0xa5, 0x79, 0x25, 0x12, 0x4c, 0x0a, 0x8c,
+	0x1a, 0x2c, 0x41, 0x70, 0xbe, 0x90, 0x0c, 0x17, 0x67, 0x4e, 0x7e, 0x71, 0x09, 0x44, 0x92, 0x19,
+	0x2c, 0x89, 0x10, 0x10, 0x52, 0xe3, 0xe2, 0x4b, 0xcb, 0x2f, 0x4a, 0x4b, 0xcd, 0x2c, 0x49, 0x4d,
+	0x81, 0x28, 0x61, 0x01, 0x2b, 0x41, 0x13, 0x15, 0x92, 0xe3, 0xe2, 0x4a, 0x29, 0x4a, 0x2c, 0x87,
+	0xda, 0xc1, 0x0a, 0x56, 0x83, 0x24, 0x22, 0xa4, 0xc2, 0xc5, 0x5b, 0x94, 0x5a, 0x9c, 0x99, 0x9e,
+	0x07, 0x33, 0x86, 0x0d, 0xac, 0x04, 0x55, 0xd0, 0xc9, 0xe5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
+	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
+	0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
+	0xc1, 0x41, 0xa1, 0x0f, 0x0f, 0xac, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
+	0x1c, 0x64, 0xc6, 0x80, 0x01, 0x00, 0x68, 0xc3, 0xa1, 0xd9, 0x50, 0x01, 0x00, 0x00,
 }
 
 func (m *PlayerInfo) Marshal() (dAtA []byte, err error) {
@@ -133,6 +151,16 @@ func (m *PlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResignedCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.ResignedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DrawnCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.DrawnCount))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ForfeitedCount != 0 {
 		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.ForfeitedCount))
 		i--
@@ -188,6 +216,12 @@ func (m *PlayerInfo) Size() (n int) {
 	if m.ForfeitedCount != 0 {
 		n += 1 + sovPlayerInfo(uint64(m.ForfeitedCount))
 	}
+	if m.DrawnCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.DrawnCount))
+	}
+	if m.ResignedCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.ResignedCount))
+	}
 	return n
 }
 
@@ -315,6 +349,44 @@ func (m *PlayerInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawnCount", wireType)
+			}
+			m.DrawnCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawnCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResignedCount", wireType)
+			}
+			m.ResignedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResignedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlayerInfo(dAtA[iNdEx:])
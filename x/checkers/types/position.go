@@ -0,0 +1,17 @@
+package types
+
+import "github.com/alice/checkers/x/checkers/rules"
+
+func NewPosition(pos rules.Pos) Position {
+	return Position{
+		X: uint64(pos.X),
+		Y: uint64(pos.Y),
+	}
+}
+
+func (position Position) ToPos() rules.Pos {
+	return rules.Pos{
+		X: int(position.X),
+		Y: int(position.Y),
+	}
+}
@@ -5,16 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,19 +29,161 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Outcome tells how a game ended.
+type Outcome int32
+
+const (
+	Outcome_OUTCOME_IN_PROGRESS Outcome = 0
+	// Finished before outcomes were recorded
+	Outcome_OUTCOME_UNKNOWN          Outcome = 1
+	Outcome_OUTCOME_CAPTURE_OUT      Outcome = 2
+	Outcome_OUTCOME_BLOCKED          Outcome = 3
+	Outcome_OUTCOME_TIMEOUT          Outcome = 4
+	Outcome_OUTCOME_RESIGNATION      Outcome = 5
+	Outcome_OUTCOME_DRAW_AGREED      Outcome = 6
+	Outcome_OUTCOME_DRAW_REPETITION  Outcome = 7
+	Outcome_OUTCOME_DRAW_NO_PROGRESS Outcome = 8
+	Outcome_OUTCOME_REJECTED         Outcome = 9
+	Outcome_OUTCOME_EXPIRED          Outcome = 10
+	// The player to move ran out of time bank
+	Outcome_OUTCOME_FLAGGED Outcome = 11
+)
+
+var Outcome_name = map[int32]string{
+	0:  "OUTCOME_IN_PROGRESS",
+	1:  "OUTCOME_UNKNOWN",
+	2:  "OUTCOME_CAPTURE_OUT",
+	3:  "OUTCOME_BLOCKED",
+	4:  "OUTCOME_TIMEOUT",
+	5:  "OUTCOME_RESIGNATION",
+	6:  "OUTCOME_DRAW_AGREED",
+	7:  "OUTCOME_DRAW_REPETITION",
+	8:  "OUTCOME_DRAW_NO_PROGRESS",
+	9:  "OUTCOME_REJECTED",
+	10: "OUTCOME_EXPIRED",
+	11: "OUTCOME_FLAGGED",
+}
+
+var Outcome_value = map[string]int32{
+	"OUTCOME_IN_PROGRESS":      0,
+	"OUTCOME_UNKNOWN":          1,
+	"OUTCOME_CAPTURE_OUT":      2,
+	"OUTCOME_BLOCKED":          3,
+	"OUTCOME_TIMEOUT":          4,
+	"OUTCOME_RESIGNATION":      5,
+	"OUTCOME_DRAW_AGREED":      6,
+	"OUTCOME_DRAW_REPETITION":  7,
+	"OUTCOME_DRAW_NO_PROGRESS": 8,
+	"OUTCOME_REJECTED":         9,
+	"OUTCOME_EXPIRED":          10,
+	"OUTCOME_FLAGGED":          11,
+}
+
+func (x Outcome) String() string {
+	return proto.EnumName(Outcome_name, int32(x))
+}
+
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8439c9c90688ff75, []int{0}
+}
+
+// GameStatus is the lifecycle state of a game.
+type GameStatus int32
+
+const (
+	// Not set, never valid on a stored game
+	GameStatus_GAME_STATUS_UNSPECIFIED GameStatus = 0
+	// Created, waiting for both players to make their first move
+	GameStatus_GAME_STATUS_OPEN      GameStatus = 1
+	GameStatus_GAME_STATUS_ACTIVE    GameStatus = 2
+	GameStatus_GAME_STATUS_FINISHED  GameStatus = 3
+	GameStatus_GAME_STATUS_DRAWN     GameStatus = 4
+	GameStatus_GAME_STATUS_FORFEITED GameStatus = 5
+	GameStatus_GAME_STATUS_REJECTED  GameStatus = 6
+	// Timed out before both players had moved
+	GameStatus_GAME_STATUS_EXPIRED GameStatus = 7
+	// Waiting for the invited players to accept, nothing is charged yet
+	GameStatus_GAME_STATUS_PENDING GameStatus = 8
+)
+
+var GameStatus_name = map[int32]string{
+	0: "GAME_STATUS_UNSPECIFIED",
+	1: "GAME_STATUS_OPEN",
+	2: "GAME_STATUS_ACTIVE",
+	3: "GAME_STATUS_FINISHED",
+	4: "GAME_STATUS_DRAWN",
+	5: "GAME_STATUS_FORFEITED",
+	6: "GAME_STATUS_REJECTED",
+	7: "GAME_STATUS_EXPIRED",
+	8: "GAME_STATUS_PENDING",
+}
+
+var GameStatus_value = map[string]int32{
+	"GAME_STATUS_UNSPECIFIED": 0,
+	"GAME_STATUS_OPEN":        1,
+	"GAME_STATUS_ACTIVE":      2,
+	"GAME_STATUS_FINISHED":    3,
+	"GAME_STATUS_DRAWN":       4,
+	"GAME_STATUS_FORFEITED":   5,
+	"GAME_STATUS_REJECTED":    6,
+	"GAME_STATUS_EXPIRED":     7,
+	"GAME_STATUS_PENDING":     8,
+}
+
+func (x GameStatus) String() string {
+	return proto.EnumName(GameStatus_name, int32(x))
+}
+
+func (GameStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8439c9c90688ff75, []int{1}
+}
+
 type StoredGame struct {
-	Index       string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Board       string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
-	Turn        string `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
-	Black       string `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
-	Red         string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
-	MoveCount   uint64 `protobuf:"varint,6,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
-	BeforeIndex string `protobuf:"bytes,7,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
-	AfterIndex  string `protobuf:"bytes,8,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
-	Deadline    string `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Winner      string `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
-	Wager       uint64 `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
-	Denom       string `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	Index     string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Board     string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Turn      string `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	Black     string `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
+	Red       string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
+	MoveCount uint64 `protobuf:"varint,6,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	Deadline  string `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Winner    string `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
+	// Wager of each player when both stake the same coin, 0 and empty otherwise. What is escrowed is blackWager and
+	// redWager.
+	Wager           uint64        `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
+	Denom           string        `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	MustJumpFrom    *Position     `protobuf:"bytes,13,opt,name=mustJumpFrom,proto3" json:"mustJumpFrom,omitempty"`
+	DrawOfferer     string        `protobuf:"bytes,14,opt,name=drawOfferer,proto3" json:"drawOfferer,omitempty"`
+	PositionHistory []string      `protobuf:"bytes,15,rep,name=positionHistory,proto3" json:"positionHistory,omitempty"`
+	NoProgressCount uint64        `protobuf:"varint,16,opt,name=noProgressCount,proto3" json:"noProgressCount,omitempty"`
+	Outcome         Outcome       `protobuf:"varint,17,opt,name=outcome,proto3,enum=alice.checkers.checkers.Outcome" json:"outcome,omitempty"`
+	Status          GameStatus    `protobuf:"varint,18,opt,name=status,proto3,enum=alice.checkers.checkers.GameStatus" json:"status,omitempty"`
+	TurnDuration    time.Duration `protobuf:"bytes,19,opt,name=turnDuration,proto3,stdduration" json:"turnDuration"`
+	// Time bank each player started with, 0 when played without a clock
+	TimeBank  time.Duration `protobuf:"bytes,20,opt,name=timeBank,proto3,stdduration" json:"timeBank"`
+	Increment time.Duration `protobuf:"bytes,21,opt,name=increment,proto3,stdduration" json:"increment"`
+	// Time left to each player as of the start of the current turn
+	BlackClock time.Duration `protobuf:"bytes,22,opt,name=blackClock,proto3,stdduration" json:"blackClock"`
+	RedClock   time.Duration `protobuf:"bytes,23,opt,name=redClock,proto3,stdduration" json:"redClock"`
+	// Only kept for games with a clock
+	TurnStartedAt string `protobuf:"bytes,24,opt,name=turnStartedAt,proto3" json:"turnStartedAt,omitempty"`
+	// When not 0, the deadline is deadlineHeight instead of deadline
+	TurnBlocks     uint64 `protobuf:"varint,25,opt,name=turnBlocks,proto3" json:"turnBlocks,omitempty"`
+	DeadlineHeight int64  `protobuf:"varint,26,opt,name=deadlineHeight,proto3" json:"deadlineHeight,omitempty"`
+	// Color of the seat taken with MsgJoinGame, whose wager was collected on joining
+	JoinedColor string `protobuf:"bytes,27,opt,name=joinedColor,proto3" json:"joinedColor,omitempty"`
+	// Players named by the creator who have yet to accept with MsgAcceptGame
+	Invitees []string `protobuf:"bytes,28,rep,name=invitees,proto3" json:"invitees,omitempty"`
+	// When true, both wagers are escrowed as soon as the game becomes active instead of on each first move
+	UpFrontEscrow bool `protobuf:"varint,29,opt,name=upFrontEscrow,proto3" json:"upFrontEscrow,omitempty"`
+	// Colors whose wager is in escrow
+	PaidColors []string `protobuf:"bytes,30,rep,name=paidColors,proto3" json:"paidColors,omitempty"`
+	// Coins staked by each player, which may differ when giving odds
+	BlackWager github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,31,rep,name=blackWager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"blackWager"`
+	RedWager   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,32,rep,name=redWager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redWager"`
+	// Account that put up the prize, refunded when the game ends without a winner
+	Sponsor       string                                   `protobuf:"bytes,33,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	Prize         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,34,rep,name=prize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize"`
+	PrizeInEscrow bool                                     `protobuf:"varint,35,opt,name=prizeInEscrow,proto3" json:"prizeInEscrow,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -112,75 +261,273 @@ func (m *StoredGame) GetMoveCount() uint64 {
 	return 0
 }
 
-func (m *StoredGame) GetBeforeIndex() string {
+func (m *StoredGame) GetDeadline() string {
 	if m != nil {
-		return m.BeforeIndex
+		return m.Deadline
 	}
 	return ""
 }
 
-func (m *StoredGame) GetAfterIndex() string {
+func (m *StoredGame) GetWinner() string {
 	if m != nil {
-		return m.AfterIndex
+		return m.Winner
 	}
 	return ""
 }
 
-func (m *StoredGame) GetDeadline() string {
+func (m *StoredGame) GetWager() uint64 {
 	if m != nil {
-		return m.Deadline
+		return m.Wager
+	}
+	return 0
+}
+
+func (m *StoredGame) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *StoredGame) GetWinner() string {
+func (m *StoredGame) GetMustJumpFrom() *Position {
 	if m != nil {
-		return m.Winner
+		return m.MustJumpFrom
+	}
+	return nil
+}
+
+func (m *StoredGame) GetDrawOfferer() string {
+	if m != nil {
+		return m.DrawOfferer
 	}
 	return ""
 }
 
-func (m *StoredGame) GetWager() uint64 {
+func (m *StoredGame) GetPositionHistory() []string {
 	if m != nil {
-		return m.Wager
+		return m.PositionHistory
+	}
+	return nil
+}
+
+func (m *StoredGame) GetNoProgressCount() uint64 {
+	if m != nil {
+		return m.NoProgressCount
 	}
 	return 0
 }
 
-func (m *StoredGame) GetDenom() string {
+func (m *StoredGame) GetOutcome() Outcome {
 	if m != nil {
-		return m.Denom
+		return m.Outcome
+	}
+	return Outcome_OUTCOME_IN_PROGRESS
+}
+
+func (m *StoredGame) GetStatus() GameStatus {
+	if m != nil {
+		return m.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (m *StoredGame) GetTurnDuration() time.Duration {
+	if m != nil {
+		return m.TurnDuration
+	}
+	return 0
+}
+
+func (m *StoredGame) GetTimeBank() time.Duration {
+	if m != nil {
+		return m.TimeBank
+	}
+	return 0
+}
+
+func (m *StoredGame) GetIncrement() time.Duration {
+	if m != nil {
+		return m.Increment
+	}
+	return 0
+}
+
+func (m *StoredGame) GetBlackClock() time.Duration {
+	if m != nil {
+		return m.BlackClock
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRedClock() time.Duration {
+	if m != nil {
+		return m.RedClock
+	}
+	return 0
+}
+
+func (m *StoredGame) GetTurnStartedAt() string {
+	if m != nil {
+		return m.TurnStartedAt
+	}
+	return ""
+}
+
+func (m *StoredGame) GetTurnBlocks() uint64 {
+	if m != nil {
+		return m.TurnBlocks
+	}
+	return 0
+}
+
+func (m *StoredGame) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *StoredGame) GetJoinedColor() string {
+	if m != nil {
+		return m.JoinedColor
+	}
+	return ""
+}
+
+func (m *StoredGame) GetInvitees() []string {
+	if m != nil {
+		return m.Invitees
+	}
+	return nil
+}
+
+func (m *StoredGame) GetUpFrontEscrow() bool {
+	if m != nil {
+		return m.UpFrontEscrow
+	}
+	return false
+}
+
+func (m *StoredGame) GetPaidColors() []string {
+	if m != nil {
+		return m.PaidColors
+	}
+	return nil
+}
+
+func (m *StoredGame) GetBlackWager() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlackWager
+	}
+	return nil
+}
+
+func (m *StoredGame) GetRedWager() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RedWager
+	}
+	return nil
+}
+
+func (m *StoredGame) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
 	return ""
 }
 
+func (m *StoredGame) GetPrize() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Prize
+	}
+	return nil
+}
+
+func (m *StoredGame) GetPrizeInEscrow() bool {
+	if m != nil {
+		return m.PrizeInEscrow
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("alice.checkers.checkers.Outcome", Outcome_name, Outcome_value)
+	proto.RegisterEnum("alice.checkers.checkers.GameStatus", GameStatus_name, GameStatus_value)
 	proto.RegisterType((*StoredGame)(nil), "alice.checkers.checkers.StoredGame")
 }
 
 func init() { proto.RegisterFile("checkers/stored_game.proto", fileDescriptor_8439c9c90688ff75) }
 
 var fileDescriptor_8439c9c90688ff75 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0xeb, 0xfe, 0x7d, 0xed, 0xe9, 0x37, 0x20, 0x0b, 0x81, 0x55, 0x21, 0xab, 0x62, 0xaa,
-	0x18, 0xda, 0x81, 0x3b, 0x00, 0x24, 0xc4, 0x5a, 0x36, 0x16, 0xe4, 0xc4, 0xa7, 0x69, 0xd4, 0xc4,
-	0xae, 0x1c, 0x87, 0x96, 0xbb, 0xe0, 0x62, 0xb8, 0x08, 0xc6, 0x8e, 0x8c, 0x28, 0xb9, 0x11, 0x94,
-	0x93, 0x92, 0x76, 0x7b, 0x9f, 0xe7, 0xbc, 0x91, 0x4e, 0x7c, 0x60, 0x1c, 0xae, 0x30, 0x5c, 0xa3,
-	0xcb, 0xe6, 0x99, 0xb7, 0x0e, 0xf5, 0x6b, 0xa4, 0x52, 0x9c, 0x6d, 0x9c, 0xf5, 0x96, 0x5f, 0xaa,
-	0x24, 0x0e, 0x71, 0xf6, 0xd7, 0x68, 0xc2, 0xf5, 0x67, 0x1b, 0xe0, 0x99, 0xea, 0x8f, 0x2a, 0x45,
-	0x7e, 0x0e, 0xbd, 0xd8, 0x68, 0xdc, 0x09, 0x36, 0x61, 0xd3, 0xe1, 0xa2, 0x86, 0xca, 0x06, 0x56,
-	0x39, 0x2d, 0xda, 0xb5, 0x25, 0xe0, 0x1c, 0xba, 0x3e, 0x77, 0x46, 0x74, 0x48, 0x52, 0xa6, 0x66,
-	0xa2, 0xc2, 0xb5, 0xe8, 0x1e, 0x9a, 0x15, 0xf0, 0x33, 0xe8, 0x38, 0xd4, 0xa2, 0x47, 0xae, 0x8a,
-	0xfc, 0x0a, 0x86, 0xa9, 0x7d, 0xc3, 0x7b, 0x9b, 0x1b, 0x2f, 0xfa, 0x13, 0x36, 0xed, 0x2e, 0x8e,
-	0x82, 0x4f, 0x60, 0x14, 0xe0, 0xd2, 0x3a, 0x7c, 0xa2, 0x5d, 0xfe, 0xd1, 0x77, 0xa7, 0x8a, 0x4b,
-	0x00, 0xb5, 0xf4, 0xe8, 0xea, 0xc2, 0x80, 0x0a, 0x27, 0x86, 0x8f, 0x61, 0xa0, 0x51, 0xe9, 0x24,
-	0x36, 0x28, 0x86, 0x34, 0x6d, 0x98, 0x5f, 0x40, 0x7f, 0x1b, 0x1b, 0x83, 0x4e, 0x00, 0x4d, 0x0e,
-	0x54, 0xed, 0xbe, 0x55, 0x11, 0x3a, 0x31, 0xa2, 0x7d, 0x6a, 0xa8, 0xac, 0x46, 0x63, 0x53, 0xf1,
-	0xbf, 0xfe, 0x23, 0x82, 0xbb, 0x87, 0xaf, 0x42, 0xb2, 0x7d, 0x21, 0xd9, 0x4f, 0x21, 0xd9, 0x47,
-	0x29, 0x5b, 0xfb, 0x52, 0xb6, 0xbe, 0x4b, 0xd9, 0x7a, 0xb9, 0x89, 0x62, 0xbf, 0xca, 0x83, 0x59,
-	0x68, 0xd3, 0x39, 0x3d, 0xfa, 0xbc, 0x39, 0xcb, 0xee, 0x18, 0xfd, 0xfb, 0x06, 0xb3, 0xa0, 0x4f,
-	0xc7, 0xb9, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x40, 0xa8, 0x3b, 0x40, 0xba, 0x01, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0x17, 0x2d, 0x59, 0x1f, 0x6b, 0xc7, 0x66, 0xd6, 0x5f, 0x6b, 0xc7, 0x7f, 0x59, 0x49, 0xfe,
+	0x28, 0x04, 0x03, 0xa5, 0x9a, 0xf4, 0xd6, 0x1e, 0x0a, 0x89, 0x5a, 0xcb, 0x74, 0x62, 0x52, 0xa0,
+	0xa4, 0xba, 0xe8, 0x45, 0xa0, 0xc8, 0xb5, 0xcc, 0x4a, 0xe4, 0x0a, 0xcb, 0x95, 0x9d, 0xf4, 0x15,
+	0x7a, 0xe9, 0xb1, 0x6f, 0x50, 0xa0, 0x4f, 0x92, 0x63, 0x8e, 0x3d, 0x35, 0x85, 0xfd, 0x00, 0x7d,
+	0x85, 0x62, 0x97, 0x94, 0x44, 0x19, 0x30, 0xe0, 0x02, 0x39, 0x79, 0xe6, 0x37, 0xf3, 0x9b, 0x9d,
+	0x2f, 0x8e, 0x05, 0x0e, 0xdc, 0x2b, 0xe2, 0x8e, 0x08, 0x8b, 0x6a, 0x11, 0xa7, 0x8c, 0x78, 0xfd,
+	0xa1, 0x13, 0x10, 0x6d, 0xc2, 0x28, 0xa7, 0x70, 0xcf, 0x19, 0xfb, 0x2e, 0xd1, 0x66, 0x1e, 0x73,
+	0xe1, 0x60, 0x6f, 0x4e, 0x9a, 0xd0, 0xc8, 0xe7, 0x3e, 0x0d, 0x63, 0xc6, 0xc1, 0xf6, 0x90, 0x0e,
+	0xa9, 0x14, 0x6b, 0x42, 0x4a, 0xd0, 0xf2, 0x90, 0xd2, 0xe1, 0x98, 0xd4, 0xa4, 0x36, 0x98, 0x5e,
+	0xd6, 0xbc, 0x29, 0x73, 0x52, 0xac, 0xb2, 0x4b, 0xa3, 0x80, 0x46, 0xb5, 0x81, 0x13, 0x91, 0xda,
+	0xf5, 0xab, 0x01, 0xe1, 0xce, 0xab, 0x9a, 0x4b, 0xfd, 0xc4, 0xfe, 0xe2, 0x97, 0x75, 0x00, 0x3a,
+	0x32, 0xbb, 0x96, 0x13, 0x10, 0xb8, 0x0d, 0x56, 0xfd, 0xd0, 0x23, 0xef, 0x90, 0x52, 0x51, 0xaa,
+	0x25, 0x3b, 0x56, 0x04, 0x3a, 0xa0, 0x0e, 0xf3, 0xd0, 0x4a, 0x8c, 0x4a, 0x05, 0x42, 0x90, 0xe3,
+	0x53, 0x16, 0xa2, 0xac, 0x04, 0xa5, 0x2c, 0x3d, 0xc7, 0x8e, 0x3b, 0x42, 0xb9, 0xc4, 0x53, 0x28,
+	0x50, 0x05, 0x59, 0x46, 0x3c, 0xb4, 0x2a, 0x31, 0x21, 0xc2, 0x43, 0x50, 0x0a, 0xe8, 0x35, 0xd1,
+	0xe9, 0x34, 0xe4, 0x28, 0x5f, 0x51, 0xaa, 0x39, 0x7b, 0x01, 0xc0, 0x03, 0x50, 0xf4, 0x88, 0xe3,
+	0x8d, 0xfd, 0x90, 0xa0, 0x92, 0x24, 0xcd, 0x75, 0xb8, 0x0b, 0xf2, 0x37, 0x7e, 0x18, 0x12, 0x86,
+	0x80, 0xb4, 0x24, 0x9a, 0x78, 0xf9, 0xc6, 0x19, 0x12, 0x86, 0xd6, 0x64, 0xb4, 0x58, 0x11, 0xa8,
+	0x47, 0x42, 0x1a, 0xa0, 0xf5, 0x38, 0x1f, 0xa9, 0x40, 0x0c, 0xd6, 0x83, 0x69, 0xc4, 0xcf, 0xa6,
+	0xc1, 0xe4, 0x84, 0xd1, 0x00, 0x3d, 0xa9, 0x28, 0xd5, 0xb5, 0xd7, 0xcf, 0xb5, 0x07, 0x66, 0xa2,
+	0xb5, 0x93, 0x49, 0xd8, 0x4b, 0x34, 0x58, 0x01, 0x6b, 0x1e, 0x73, 0x6e, 0xac, 0xcb, 0x4b, 0xc2,
+	0x08, 0x43, 0x1b, 0xf2, 0x89, 0x34, 0x04, 0xab, 0x60, 0x73, 0x36, 0xc5, 0x53, 0x5f, 0x2c, 0xc1,
+	0x7b, 0xb4, 0x59, 0xc9, 0x56, 0x4b, 0xf6, 0x7d, 0x58, 0x78, 0x86, 0xb4, 0xcd, 0xe8, 0x90, 0x91,
+	0x28, 0x8a, 0xdb, 0xa2, 0xca, 0x42, 0xee, 0xc3, 0xf0, 0x1b, 0x50, 0xa0, 0x53, 0xee, 0xd2, 0x80,
+	0xa0, 0xa7, 0x15, 0xa5, 0xba, 0xf1, 0xba, 0xf2, 0x60, 0xde, 0x56, 0xec, 0x67, 0xcf, 0x08, 0xf0,
+	0x5b, 0x90, 0x8f, 0xb8, 0xc3, 0xa7, 0x11, 0x82, 0x92, 0xfa, 0xf2, 0x41, 0xaa, 0xd8, 0x86, 0x8e,
+	0x74, 0xb5, 0x13, 0x0a, 0x6c, 0x81, 0x75, 0x31, 0xe3, 0x66, 0xb2, 0x60, 0x68, 0x4b, 0x76, 0x6d,
+	0x5f, 0x8b, 0x37, 0x50, 0x9b, 0x6d, 0xa0, 0x36, 0x73, 0x68, 0x14, 0x3f, 0xfc, 0x75, 0x94, 0xf9,
+	0xed, 0xd3, 0x91, 0x62, 0x2f, 0x11, 0xe1, 0x77, 0xa0, 0xc8, 0xfd, 0x80, 0x34, 0x9c, 0x70, 0x84,
+	0xb6, 0x1f, 0x1f, 0x64, 0x4e, 0x82, 0x75, 0x50, 0xf2, 0x43, 0x97, 0x91, 0x80, 0x84, 0x1c, 0xed,
+	0x3c, 0x3e, 0xc2, 0x82, 0x05, 0x75, 0x00, 0xe4, 0x6e, 0xea, 0x63, 0xea, 0x8e, 0xd0, 0xee, 0xe3,
+	0x63, 0xa4, 0x68, 0xa2, 0x10, 0x46, 0xbc, 0x38, 0xc4, 0xde, 0x7f, 0x28, 0x64, 0x46, 0x82, 0xff,
+	0x07, 0x4f, 0x44, 0x67, 0x3a, 0xdc, 0x61, 0x9c, 0x78, 0x75, 0x8e, 0x90, 0xdc, 0xa1, 0x65, 0x10,
+	0x96, 0x01, 0x10, 0x40, 0x43, 0x50, 0x22, 0xb4, 0x2f, 0xd7, 0x22, 0x85, 0xc0, 0x2f, 0xc0, 0xc6,
+	0xec, 0xf3, 0x38, 0x25, 0xfe, 0xf0, 0x8a, 0xa3, 0x83, 0x8a, 0x52, 0xcd, 0xda, 0xf7, 0x50, 0xb1,
+	0xaf, 0x3f, 0x51, 0x3f, 0x24, 0x9e, 0x4e, 0xc7, 0x94, 0xa1, 0x67, 0xf1, 0xbe, 0xa6, 0x20, 0xf1,
+	0xe1, 0xf9, 0xe1, 0xb5, 0xcf, 0x09, 0x89, 0xd0, 0xa1, 0x5c, 0xd4, 0xb9, 0x2e, 0x72, 0x9d, 0x8a,
+	0xbd, 0x0f, 0x39, 0x8e, 0x5c, 0x46, 0x6f, 0xd0, 0xff, 0x2a, 0x4a, 0xb5, 0x68, 0x2f, 0x83, 0x22,
+	0xd7, 0x89, 0xe3, 0xc7, 0xe1, 0x22, 0x54, 0x96, 0x31, 0x52, 0x08, 0x1c, 0x25, 0x7d, 0xbf, 0x90,
+	0xdf, 0xea, 0x51, 0x25, 0x2b, 0x9b, 0x16, 0x1f, 0x29, 0x4d, 0x1c, 0x29, 0x2d, 0x39, 0x52, 0x9a,
+	0x4e, 0xfd, 0xb0, 0xf1, 0x95, 0x68, 0xda, 0x1f, 0x9f, 0x8e, 0xaa, 0x43, 0x9f, 0x5f, 0x4d, 0x07,
+	0x9a, 0x4b, 0x83, 0x5a, 0x72, 0xd1, 0xe2, 0x3f, 0x5f, 0x46, 0xde, 0xa8, 0xc6, 0xdf, 0x4f, 0x48,
+	0x24, 0x09, 0x91, 0x9d, 0x0a, 0x0f, 0x87, 0x72, 0x3e, 0xf1, 0x53, 0x95, 0xcf, 0xff, 0xd4, 0x3c,
+	0x38, 0x44, 0xa0, 0x10, 0x4d, 0x68, 0x18, 0x51, 0x86, 0x9e, 0xcb, 0xae, 0xce, 0x54, 0xe8, 0x80,
+	0xd5, 0x09, 0xf3, 0x7f, 0x26, 0xe8, 0xc5, 0xe7, 0x7f, 0x3f, 0x8e, 0x2c, 0x06, 0x23, 0x05, 0x23,
+	0x4c, 0x06, 0xf3, 0x32, 0x1e, 0xcc, 0x12, 0x78, 0x96, 0x2b, 0x16, 0xd4, 0xe2, 0x59, 0xae, 0x58,
+	0x54, 0x4b, 0xf6, 0xda, 0x80, 0x5c, 0x52, 0x46, 0x0c, 0x71, 0xdc, 0x6d, 0xe0, 0x5c, 0x72, 0xc2,
+	0xa4, 0x7c, 0xfc, 0xfb, 0x0a, 0x28, 0x24, 0x47, 0x03, 0xee, 0x81, 0x2d, 0xab, 0xd7, 0xd5, 0xad,
+	0x73, 0xdc, 0x37, 0xcc, 0x7e, 0xdb, 0xb6, 0x5a, 0x36, 0xee, 0x74, 0xd4, 0x0c, 0xdc, 0x02, 0x9b,
+	0x33, 0x43, 0xcf, 0x7c, 0x63, 0x5a, 0x17, 0xa6, 0xaa, 0xa4, 0xbd, 0xf5, 0x7a, 0xbb, 0xdb, 0xb3,
+	0x71, 0xdf, 0xea, 0x75, 0xd5, 0x95, 0xb4, 0x77, 0xe3, 0xad, 0xa5, 0xbf, 0xc1, 0x4d, 0x35, 0x9b,
+	0x06, 0xbb, 0xc6, 0x39, 0x16, 0x9e, 0xb9, 0x74, 0x08, 0x1b, 0x77, 0x8c, 0x96, 0x59, 0xef, 0x1a,
+	0x96, 0xa9, 0xae, 0xa6, 0x0d, 0x4d, 0xbb, 0x7e, 0xd1, 0xaf, 0xb7, 0x6c, 0x8c, 0x9b, 0x6a, 0x1e,
+	0x3e, 0x03, 0x7b, 0x4b, 0x06, 0x1b, 0xb7, 0x71, 0xd7, 0x90, 0xac, 0x02, 0x3c, 0x04, 0x68, 0xc9,
+	0x68, 0x5a, 0x8b, 0x22, 0x8a, 0x70, 0x1b, 0xa8, 0x8b, 0xc7, 0xce, 0xb0, 0xde, 0xc5, 0x4d, 0xb5,
+	0x94, 0xce, 0x0b, 0xff, 0xd0, 0x36, 0x6c, 0xdc, 0x54, 0x41, 0x1a, 0x3c, 0x79, 0x5b, 0x6f, 0xb5,
+	0x70, 0x53, 0x5d, 0x3b, 0xfe, 0x47, 0x01, 0x60, 0x71, 0x23, 0x45, 0x26, 0xad, 0xfa, 0x39, 0xee,
+	0x77, 0xba, 0xf5, 0x6e, 0xaf, 0xd3, 0xef, 0x99, 0x9d, 0x36, 0xd6, 0x8d, 0x13, 0x03, 0x37, 0xd5,
+	0x8c, 0x78, 0x2b, 0x6d, 0xb4, 0xda, 0x58, 0x74, 0x6c, 0x17, 0xc0, 0x34, 0x5a, 0xd7, 0xbb, 0xc6,
+	0xf7, 0x58, 0x5d, 0x81, 0x08, 0x6c, 0xa7, 0xf1, 0x13, 0xc3, 0x34, 0x3a, 0xa7, 0xb2, 0x6b, 0x3b,
+	0xe0, 0x69, 0xda, 0x22, 0xaa, 0x32, 0xd5, 0x1c, 0xdc, 0x07, 0x3b, 0x4b, 0x04, 0xcb, 0x3e, 0xc1,
+	0x86, 0xa8, 0x67, 0xf5, 0x7e, 0xac, 0x79, 0xa5, 0x79, 0xd1, 0xd3, 0xb4, 0x65, 0x56, 0x6d, 0xe1,
+	0xbe, 0xa1, 0x8d, 0xcd, 0xa6, 0x61, 0xb6, 0xd4, 0x62, 0xa3, 0xf9, 0xe1, 0xb6, 0xac, 0x7c, 0xbc,
+	0x2d, 0x2b, 0x7f, 0xdf, 0x96, 0x95, 0x5f, 0xef, 0xca, 0x99, 0x8f, 0x77, 0xe5, 0xcc, 0x9f, 0x77,
+	0xe5, 0xcc, 0x8f, 0xc7, 0xa9, 0x8d, 0x95, 0xff, 0x4f, 0x6a, 0xf3, 0xdf, 0x30, 0xef, 0x16, 0xa2,
+	0xdc, 0xdc, 0x41, 0x5e, 0x1e, 0xc6, 0xaf, 0xff, 0x1d, 0x00, 0x87, 0xe5, 0xe4, 0x4b, 0x1c, 0x09,
+	0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -203,6 +550,240 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PrizeInEscrow {
+		i--
+		if m.PrizeInEscrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Prize) > 0 {
+		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RedWager) > 0 {
+		for iNdEx := len(m.RedWager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedWager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BlackWager) > 0 {
+		for iNdEx := len(m.BlackWager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlackWager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.PaidColors) > 0 {
+		for iNdEx := len(m.PaidColors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PaidColors[iNdEx])
+			copy(dAtA[i:], m.PaidColors[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PaidColors[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.UpFrontEscrow {
+		i--
+		if m.UpFrontEscrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.Invitees) > 0 {
+		for iNdEx := len(m.Invitees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Invitees[iNdEx])
+			copy(dAtA[i:], m.Invitees[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Invitees[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.JoinedColor) > 0 {
+		i -= len(m.JoinedColor)
+		copy(dAtA[i:], m.JoinedColor)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.JoinedColor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.TurnBlocks != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.TurnBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.TurnStartedAt) > 0 {
+		i -= len(m.TurnStartedAt)
+		copy(dAtA[i:], m.TurnStartedAt)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.TurnStartedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RedClock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedClock):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStoredGame(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BlackClock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlackClock):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStoredGame(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Increment, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Increment):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStoredGame(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeBank, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeBank):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStoredGame(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TurnDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TurnDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStoredGame(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.Status != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.NoProgressCount != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.NoProgressCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.PositionHistory) > 0 {
+		for iNdEx := len(m.PositionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PositionHistory[iNdEx])
+			copy(dAtA[i:], m.PositionHistory[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PositionHistory[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DrawOfferer) > 0 {
+		i -= len(m.DrawOfferer)
+		copy(dAtA[i:], m.DrawOfferer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.DrawOfferer)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.MustJumpFrom != nil {
+		{
+			size, err := m.MustJumpFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStoredGame(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -229,20 +810,6 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	if len(m.AfterIndex) > 0 {
-		i -= len(m.AfterIndex)
-		copy(dAtA[i:], m.AfterIndex)
-		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.AfterIndex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BeforeIndex) > 0 {
-		i -= len(m.BeforeIndex)
-		copy(dAtA[i:], m.BeforeIndex)
-		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.BeforeIndex)))
-		i--
-		dAtA[i] = 0x3a
-	}
 	if m.MoveCount != 0 {
 		i = encodeVarintStoredGame(dAtA, i, uint64(m.MoveCount))
 		i--
@@ -326,14 +893,6 @@ func (m *StoredGame) Size() (n int) {
 	if m.MoveCount != 0 {
 		n += 1 + sovStoredGame(uint64(m.MoveCount))
 	}
-	l = len(m.BeforeIndex)
-	if l > 0 {
-		n += 1 + l + sovStoredGame(uint64(l))
-	}
-	l = len(m.AfterIndex)
-	if l > 0 {
-		n += 1 + l + sovStoredGame(uint64(l))
-	}
 	l = len(m.Deadline)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
@@ -349,6 +908,93 @@ func (m *StoredGame) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
+	if m.MustJumpFrom != nil {
+		l = m.MustJumpFrom.Size()
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.DrawOfferer)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.PositionHistory) > 0 {
+		for _, s := range m.PositionHistory {
+			l = len(s)
+			n += 1 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if m.NoProgressCount != 0 {
+		n += 2 + sovStoredGame(uint64(m.NoProgressCount))
+	}
+	if m.Outcome != 0 {
+		n += 2 + sovStoredGame(uint64(m.Outcome))
+	}
+	if m.Status != 0 {
+		n += 2 + sovStoredGame(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TurnDuration)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeBank)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Increment)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlackClock)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedClock)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = len(m.TurnStartedAt)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.TurnBlocks != 0 {
+		n += 2 + sovStoredGame(uint64(m.TurnBlocks))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 2 + sovStoredGame(uint64(m.DeadlineHeight))
+	}
+	l = len(m.JoinedColor)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.Invitees) > 0 {
+		for _, s := range m.Invitees {
+			l = len(s)
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if m.UpFrontEscrow {
+		n += 3
+	}
+	if len(m.PaidColors) > 0 {
+		for _, s := range m.PaidColors {
+			l = len(s)
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if len(m.BlackWager) > 0 {
+		for _, e := range m.BlackWager {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if len(m.RedWager) > 0 {
+		for _, e := range m.RedWager {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.Prize) > 0 {
+		for _, e := range m.Prize {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if m.PrizeInEscrow {
+		n += 3
+	}
 	return n
 }
 
@@ -566,9 +1212,9 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeforeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -596,11 +1242,11 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeforeIndex = string(dAtA[iNdEx:postIndex])
+			m.Deadline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -628,11 +1274,30 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AfterIndex = string(dAtA[iNdEx:postIndex])
+			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			m.Wager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -660,11 +1325,47 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deadline = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MustJumpFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MustJumpFrom == nil {
+				m.MustJumpFrom = &Position{}
+			}
+			if err := m.MustJumpFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawOfferer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -692,13 +1393,45 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
+			m.DrawOfferer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionHistory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionHistory = append(m.PositionHistory, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProgressCount", wireType)
 			}
-			m.Wager = 0
+			m.NoProgressCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStoredGame
@@ -708,14 +1441,217 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Wager |= uint64(b&0x7F) << shift
+				m.NoProgressCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= Outcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= GameStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TurnDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBank", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeBank, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Increment, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackClock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BlackClock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedClock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RedClock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnStartedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -743,8 +1679,316 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TurnStartedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnBlocks", wireType)
+			}
+			m.TurnBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TurnBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinedColor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinedColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invitees = append(m.Invitees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFrontEscrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpFrontEscrow = bool(v != 0)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidColors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidColors = append(m.PaidColors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackWager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlackWager = append(m.BlackWager, types.Coin{})
+			if err := m.BlackWager[len(m.BlackWager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedWager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedWager = append(m.RedWager, types.Coin{})
+			if err := m.RedWager[len(m.RedWager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prize = append(m.Prize, types.Coin{})
+			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeInEscrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrizeInEscrow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredGame(dAtA[iNdEx:])
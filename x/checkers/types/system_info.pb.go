@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,9 +26,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type SystemInfo struct {
-	NextId        uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
-	FifoHeadIndex string `protobuf:"bytes,2,opt,name=fifoHeadIndex,proto3" json:"fifoHeadIndex,omitempty"`
-	FifoTailIndex string `protobuf:"bytes,3,opt,name=fifoTailIndex,proto3" json:"fifoTailIndex,omitempty"`
+	NextId uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
+	// Rake collected on all the winnings paid so far
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalFees"`
 }
 
 func (m *SystemInfo) Reset()         { *m = SystemInfo{} }
@@ -68,18 +71,11 @@ func (m *SystemInfo) GetNextId() uint64 {
 	return 0
 }
 
-func (m *SystemInfo) GetFifoHeadIndex() string {
+func (m *SystemInfo) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.FifoHeadIndex
+		return m.TotalFees
 	}
-	return ""
-}
-
-func (m *SystemInfo) GetFifoTailIndex() string {
-	if m != nil {
-		return m.FifoTailIndex
-	}
-	return ""
+	return nil
 }
 
 func init() {
@@ -89,19 +85,26 @@ func init() {
 func init() { proto.RegisterFile("checkers/system_info.proto", fileDescriptor_1580c0dd88c0be2b) }
 
 var fileDescriptor_1580c0dd88c0be2b = []byte{
-	// 191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0xae, 0x2c, 0x2e, 0x49, 0xcd, 0x8d, 0xcf, 0xcc, 0x4b, 0xcb,
-	0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0xa9,
-	0x80, 0x33, 0x94, 0x0a, 0xb8, 0xb8, 0x82, 0xc1, 0xaa, 0x3d, 0xf3, 0xd2, 0xf2, 0x85, 0xc4, 0xb8,
-	0xd8, 0xf2, 0x52, 0x2b, 0x4a, 0x3c, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0xa0, 0x3c,
-	0x21, 0x15, 0x2e, 0xde, 0xb4, 0xcc, 0xb4, 0x7c, 0x8f, 0xd4, 0xc4, 0x14, 0xcf, 0xbc, 0x94, 0xd4,
-	0x0a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x54, 0x41, 0x98, 0xaa, 0x90, 0xc4, 0xcc, 0x1c,
-	0x88, 0x2a, 0x66, 0x84, 0x2a, 0xb8, 0xa0, 0x93, 0xcb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x69, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83,
-	0xdd, 0xab, 0x0f, 0xf7, 0x51, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6,
-	0x97, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x94, 0x2d, 0x63, 0xf5, 0x00, 0x00, 0x00,
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4e, 0x02, 0x31,
+	0x14, 0x86, 0xa7, 0x32, 0x21, 0x38, 0xc6, 0x84, 0x10, 0xa3, 0xc8, 0xa2, 0x10, 0x57, 0xc4, 0xc4,
+	0x56, 0xf4, 0x06, 0x68, 0x8c, 0xb0, 0x44, 0x57, 0x6e, 0x4c, 0xa7, 0xf3, 0x06, 0x1a, 0x98, 0x3e,
+	0x42, 0xab, 0x19, 0x6e, 0xe1, 0x39, 0xbc, 0x81, 0x37, 0x60, 0xc9, 0xd2, 0x95, 0x9a, 0x99, 0x8b,
+	0x18, 0x3a, 0x23, 0xb8, 0xea, 0xff, 0xa7, 0xff, 0xfb, 0xde, 0xcb, 0x1f, 0xb4, 0xe4, 0x04, 0xe4,
+	0x14, 0x16, 0x86, 0x9b, 0xa5, 0xb1, 0x90, 0x3c, 0x2b, 0x1d, 0x23, 0x9b, 0x2f, 0xd0, 0x62, 0xe3,
+	0x44, 0xcc, 0x94, 0x04, 0xf6, 0x97, 0xd8, 0x8a, 0xd6, 0xd1, 0x18, 0xc7, 0xe8, 0x32, 0x7c, 0xa3,
+	0x8a, 0x78, 0x8b, 0x4a, 0x34, 0x09, 0x1a, 0x1e, 0x0a, 0x03, 0xfc, 0xb5, 0x17, 0x82, 0x15, 0x3d,
+	0x2e, 0x51, 0xe9, 0xe2, 0xff, 0xec, 0x83, 0x04, 0xc1, 0x83, 0x5b, 0x32, 0xd0, 0x31, 0x36, 0x8e,
+	0x83, 0xaa, 0x86, 0xd4, 0x0e, 0xa2, 0x26, 0xe9, 0x90, 0xae, 0x3f, 0x2a, 0x5d, 0x43, 0x05, 0xfb,
+	0x16, 0xad, 0x98, 0xdd, 0x01, 0x98, 0xa6, 0xdf, 0xa9, 0x74, 0x0f, 0xae, 0x4e, 0x59, 0x81, 0x66,
+	0x1b, 0x34, 0x2b, 0xd1, 0xec, 0x06, 0x95, 0xee, 0x5f, 0xae, 0xbe, 0xda, 0xde, 0xfb, 0x77, 0xbb,
+	0x3b, 0x56, 0x76, 0xf2, 0x12, 0x32, 0x89, 0x09, 0x2f, 0xef, 0x28, 0x9e, 0x0b, 0x13, 0x4d, 0xb9,
+	0x5d, 0xce, 0xc1, 0xb8, 0x01, 0x33, 0xda, 0xd1, 0x87, 0x7e, 0x6d, 0xaf, 0x5e, 0x19, 0xfa, 0xb5,
+	0x4a, 0xdd, 0x1f, 0x1d, 0xc6, 0x2a, 0xc6, 0x7b, 0x10, 0xd1, 0x40, 0x47, 0x90, 0x16, 0xf6, 0x51,
+	0xa8, 0x99, 0xb3, 0xfd, 0xdb, 0x55, 0x46, 0xc9, 0x3a, 0xa3, 0xe4, 0x27, 0xa3, 0xe4, 0x2d, 0xa7,
+	0xde, 0x3a, 0xa7, 0xde, 0x67, 0x4e, 0xbd, 0xa7, 0xf3, 0x7f, 0x8b, 0x5d, 0x5f, 0x7c, 0xdb, 0x68,
+	0xba, 0x93, 0xee, 0x80, 0xb0, 0xea, 0x8a, 0xb8, 0xfe, 0x1d, 0x00, 0x60, 0x33, 0x7d, 0x46, 0x75,
+	0x01, 0x00, 0x00,
 }
 
 func (m *SystemInfo) Marshal() (dAtA []byte, err error) {
@@ -124,19 +127,19 @@ func (m *SystemInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.FifoTailIndex) > 0 {
-		i -= len(m.FifoTailIndex)
-		copy(dAtA[i:], m.FifoTailIndex)
-		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoTailIndex)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FifoHeadIndex) > 0 {
-		i -= len(m.FifoHeadIndex)
-		copy(dAtA[i:], m.FifoHeadIndex)
-		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoHeadIndex)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSystemInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.NextId != 0 {
 		i = encodeVarintSystemInfo(dAtA, i, uint64(m.NextId))
@@ -166,13 +169,11 @@ func (m *SystemInfo) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovSystemInfo(uint64(m.NextId))
 	}
-	l = len(m.FifoHeadIndex)
-	if l > 0 {
-		n += 1 + l + sovSystemInfo(uint64(l))
-	}
-	l = len(m.FifoTailIndex)
-	if l > 0 {
-		n += 1 + l + sovSystemInfo(uint64(l))
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovSystemInfo(uint64(l))
+		}
 	}
 	return n
 }
@@ -231,11 +232,11 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifoHeadIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSystemInfo
@@ -245,55 +246,25 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSystemInfo
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSystemInfo
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FifoHeadIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifoTailIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSystemInfo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSystemInfo
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSystemInfo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.FifoTailIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
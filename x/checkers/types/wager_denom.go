@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FindWagerDenom returns the bounds of denom if it is in the allowlist
+func FindWagerDenom(wagerDenoms []WagerDenom, denom string) (wagerDenom WagerDenom, found bool) {
+	for _, wagerDenom = range wagerDenoms {
+		if wagerDenom.Denom == denom {
+			return wagerDenom, true
+		}
+	}
+	return WagerDenom{}, false
+}
+
+// IsWithinBounds tells whether wager is between MinWager and MaxWager, when the latter is set
+func (wagerDenom WagerDenom) IsWithinBounds(wager sdk.Int) bool {
+	return wager.GTE(sdk.NewIntFromUint64(wagerDenom.MinWager)) &&
+		(wagerDenom.MaxWager == 0 || wager.LTE(sdk.NewIntFromUint64(wagerDenom.MaxWager)))
+}
+
+// CheckWager returns an error when amount of denom is not accepted as the wager of a player
+func CheckWager(wagerDenoms []WagerDenom, denom string, amount sdk.Int) error {
+	wagerDenom, found := FindWagerDenom(wagerDenoms, denom)
+	if !found {
+		return sdkerrors.Wrapf(ErrDenomNotAllowed, "%s", denom)
+	}
+	if !wagerDenom.IsWithinBounds(amount) {
+		return sdkerrors.Wrapf(ErrWagerOutOfBounds, "%s%s", amount, denom)
+	}
+	return nil
+}